@@ -1,6 +1,7 @@
 package rx
 
 import (
+	"context"
 	"io"
 	"sync/atomic"
 	"unsafe"
@@ -11,11 +12,38 @@ type Disposable interface {
 	IsDisposed() bool
 }
 
+// ErrorDisposable is a Disposable whose disposal can fail. DisposeErr disposes it and returns the error of the
+// disposal, while Dispose passes the error to the undeliverable error handler
+type ErrorDisposable interface {
+	Disposable
+	DisposeErr() error
+}
+
+// disposeErr disposes d, and returns the error of the disposal if d is an ErrorDisposable
+func disposeErr(d Disposable) error {
+	if e, ok := d.(ErrorDisposable); ok {
+		return e.DisposeErr()
+	}
+	d.Dispose()
+	return nil
+}
+
+// reportDisposeErr passes the given error of a disposal to the undeliverable error handler if it's not nil
+func reportDisposeErr(err error) {
+	if err != nil {
+		onUndeliverableError(err)
+	}
+}
+
 var Disposables = struct {
 	Disposed   func() Disposable
 	Empty      func() Disposable
 	FromFunc   func(onDisposed func()) Disposable
-	FromCloser func(closer io.Closer) Disposable
+	FromCloser func(closer io.Closer) ErrorDisposable
+	// FromContext returns a Disposable cancelling ctx with cancel once disposed, it's disposed once ctx is done
+	FromContext func(ctx context.Context, cancel context.CancelFunc) Disposable
+	// DisposeOnDone disposes d once ctx is done. Disposing the returned Disposable disposes d and stops watching ctx
+	DisposeOnDone func(ctx context.Context, d Disposable) Disposable
 }{
 	Disposed: func() Disposable {
 		return disposedDisposableInstance
@@ -28,11 +56,21 @@ var Disposables = struct {
 			onDisposed: onDisposed,
 		}
 	},
-	FromCloser: func(closer io.Closer) Disposable {
-		return &atomicDisposable{
-			onDisposed: func() {
-				_ = closer.Close() // we ignore the error intentionally
-			},
+	FromCloser: func(closer io.Closer) ErrorDisposable {
+		return &closerDisposable{
+			closer: closer,
+		}
+	},
+	FromContext: func(ctx context.Context, cancel context.CancelFunc) Disposable {
+		return &contextDisposable{
+			ctx:    ctx,
+			cancel: cancel,
+		}
+	},
+	DisposeOnDone: func(ctx context.Context, d Disposable) Disposable {
+		return &boundDisposable{
+			d:    d,
+			stop: context.AfterFunc(ctx, d.Dispose),
 		}
 	},
 }
@@ -70,14 +108,163 @@ func (a *atomicDisposable) IsDisposed() bool {
 	return atomic.LoadInt32(&a.disposed) > 0
 }
 
+var _ ErrorDisposable = (*closerDisposable)(nil)
+
+// closerDisposable closes the closer once disposed
+type closerDisposable struct {
+	disposed int32
+	closer   io.Closer
+}
+
+func (c *closerDisposable) Dispose() {
+	reportDisposeErr(c.DisposeErr())
+}
+
+func (c *closerDisposable) DisposeErr() error {
+	if atomic.CompareAndSwapInt32(&c.disposed, 0, 1) {
+		return c.closer.Close()
+	}
+	return nil
+}
+
+func (c *closerDisposable) IsDisposed() bool {
+	return atomic.LoadInt32(&c.disposed) > 0
+}
+
+var _ Disposable = (*contextDisposable)(nil)
+
+type contextDisposable struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+func (c *contextDisposable) Dispose() {
+	c.cancel()
+}
+
+func (c *contextDisposable) IsDisposed() bool {
+	return c.ctx.Err() != nil
+}
+
+var _ Disposable = (*boundDisposable)(nil)
+
+// boundDisposable is a Disposable disposed along with a context
+type boundDisposable struct {
+	d    Disposable
+	stop func() bool
+}
+
+func (b *boundDisposable) Dispose() {
+	b.stop()
+	b.d.Dispose()
+}
+
+func (b *boundDisposable) IsDisposed() bool {
+	return b.d.IsDisposed()
+}
+
 var DISPOSED = &[]Disposable{disposedDisposable{}}[0]
 
+var _ Disposable = (*DisposableRef)(nil)
+
+// DisposableRef holds a Disposable that can be set and disposed atomically, it's disposed along with the
+// Disposable it holds. The zero value is ready to use, and a DisposableRef should not be copied after first use
+type DisposableRef struct {
+	ptr atomic.Pointer[Disposable]
+}
+
+// Get returns the current Disposable, which is nil if none was set
+func (r *DisposableRef) Get() Disposable {
+	cur := r.ptr.Load()
+	if cur == nil {
+		return nil
+	}
+	return *cur
+}
+
+// Set replaces the current Disposable by d and disposes the previous one.
+// If the ref is already disposed, it disposes d and returns false
+func (r *DisposableRef) Set(d Disposable) bool {
+	for {
+		cur := r.ptr.Load()
+		if cur == DISPOSED {
+			disposeNonNil(d)
+			return false
+		}
+		if r.ptr.CompareAndSwap(cur, &d) {
+			if cur != nil {
+				disposeNonNil(*cur)
+			}
+			return true
+		}
+	}
+}
+
+// SetOnce sets d if no Disposable was set before, otherwise it disposes d and returns false
+func (r *DisposableRef) SetOnce(d Disposable) bool {
+	if d == nil {
+		return false
+	}
+	if !r.ptr.CompareAndSwap(nil, &d) {
+		d.Dispose()
+		return false
+	}
+	return true
+}
+
+// Replace replaces the current Disposable by d without disposing the previous one.
+// If the ref is already disposed, it disposes d and returns false
+func (r *DisposableRef) Replace(d Disposable) bool {
+	for {
+		cur := r.ptr.Load()
+		if cur == DISPOSED {
+			disposeNonNil(d)
+			return false
+		}
+		if r.ptr.CompareAndSwap(cur, &d) {
+			return true
+		}
+	}
+}
+
+func (r *DisposableRef) Dispose() {
+	r.TryDispose()
+}
+
+// TryDispose disposes the ref and the Disposable it holds, it returns false if the ref was already disposed
+func (r *DisposableRef) TryDispose() bool {
+	if r.ptr.Load() == DISPOSED {
+		return false
+	}
+	cur := r.ptr.Swap(DISPOSED)
+	if cur == DISPOSED {
+		return false
+	}
+	if cur != nil {
+		disposeNonNil(*cur)
+	}
+	return true
+}
+
+func (r *DisposableRef) IsDisposed() bool {
+	return r.ptr.Load() == DISPOSED
+}
+
+func disposeNonNil(d Disposable) {
+	if d != nil {
+		d.Dispose()
+	}
+}
+
 func disposableHelperIsDisposed(ptr *unsafe.Pointer) bool {
 	curPtr := atomic.LoadPointer(ptr)
 	cur := (*Disposable)(curPtr)
 	return cur == DISPOSED
 }
 
+// DisposableHelper manipulates a Disposable stored in an unsafe.Pointer.
+//
+// Deprecated: use DisposableRef instead
 var DisposableHelper = struct {
 	IsDisposed func(ptr *unsafe.Pointer) bool
 	Set        func(ptr *unsafe.Pointer, d *Disposable) bool
@@ -106,7 +293,9 @@ var DisposableHelper = struct {
 	},
 	SetOnce: func(ptr *unsafe.Pointer, d *Disposable) bool {
 		if !atomic.CompareAndSwapPointer(ptr, unsafe.Pointer(nil), unsafe.Pointer(d)) {
-			(*d).Dispose()
+			if d != nil {
+				(*d).Dispose()
+			}
 			return false
 		}
 		return true
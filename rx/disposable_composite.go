@@ -0,0 +1,247 @@
+package rx
+
+import "sync"
+
+var _ ErrorDisposable = (*CompositeDisposable)(nil)
+
+// CompositeDisposable holds a set of Disposables and disposes them all at once. It's safe for concurrent use.
+// Disposables are compared with ==, so they should be comparable, which pointers are
+type CompositeDisposable struct {
+	mu          sync.Mutex
+	disposables []Disposable
+	disposed    bool
+}
+
+// NewCompositeDisposable creates a CompositeDisposable holding the given Disposables
+func NewCompositeDisposable(disposables ...Disposable) *CompositeDisposable {
+	return &CompositeDisposable{
+		disposables: append([]Disposable(nil), disposables...),
+	}
+}
+
+// Add adds d to the composite, or disposes it and returns false if the composite is already disposed
+func (c *CompositeDisposable) Add(d Disposable) bool {
+	c.mu.Lock()
+	if !c.disposed {
+		c.disposables = append(c.disposables, d)
+		c.mu.Unlock()
+		return true
+	}
+	c.mu.Unlock()
+	d.Dispose()
+	return false
+}
+
+// Remove removes d from the composite and disposes it, it returns false if d is not in the composite
+func (c *CompositeDisposable) Remove(d Disposable) bool {
+	if c.Delete(d) {
+		d.Dispose()
+		return true
+	}
+	return false
+}
+
+// Delete removes d from the composite without disposing it, it returns false if d is not in the composite
+func (c *CompositeDisposable) Delete(d Disposable) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i := len(c.disposables) - 1; i >= 0; i-- {
+		if c.disposables[i] == d {
+			c.disposables = append(c.disposables[:i:i], c.disposables[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// Clear disposes all the Disposables of the composite and removes them, the composite can still be added to.
+// The errors of the disposals are passed to the undeliverable error handler
+func (c *CompositeDisposable) Clear() {
+	c.mu.Lock()
+	disposables := c.disposables
+	c.disposables = nil
+	c.mu.Unlock()
+	reportDisposeErr(disposeAll(disposables))
+}
+
+// Size returns the number of Disposables in the composite
+func (c *CompositeDisposable) Size() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.disposables)
+}
+
+// Dispose disposes all the Disposables of the composite in the reverse order they were added, and disposes the
+// ones added later right away. The errors of the disposals are passed to the undeliverable error handler
+func (c *CompositeDisposable) Dispose() {
+	reportDisposeErr(c.DisposeErr())
+}
+
+// DisposeErr disposes the composite like Dispose, but returns the errors of the disposals instead, as a
+// *CompositeError if there are more than one
+func (c *CompositeDisposable) DisposeErr() error {
+	c.mu.Lock()
+	if c.disposed {
+		c.mu.Unlock()
+		return nil
+	}
+	c.disposed = true
+	disposables := c.disposables
+	c.disposables = nil
+	c.mu.Unlock()
+	return disposeAll(disposables)
+}
+
+func (c *CompositeDisposable) IsDisposed() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.disposed
+}
+
+// disposeAll disposes the given Disposables in LIFO order, and aggregates the errors of their disposals
+func disposeAll(disposables []Disposable) error {
+	var errs []error
+	for i := len(disposables) - 1; i >= 0; i-- {
+		if err := disposeErr(disposables[i]); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return compositeErrorOf(errs)
+}
+
+// disposableSlot holds a single Disposable that can be swapped until the slot is disposed
+type disposableSlot struct {
+	mu       sync.Mutex
+	current  Disposable
+	disposed bool
+}
+
+func (s *disposableSlot) swap(d Disposable) (Disposable, bool) {
+	s.mu.Lock()
+	if s.disposed {
+		s.mu.Unlock()
+		if d != nil {
+			d.Dispose()
+		}
+		return nil, false
+	}
+	previous := s.current
+	s.current = d
+	s.mu.Unlock()
+	return previous, true
+}
+
+func (s *disposableSlot) get() Disposable {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.disposed {
+		return Disposables.Disposed()
+	}
+	return s.current
+}
+
+func (s *disposableSlot) dispose() error {
+	s.mu.Lock()
+	if s.disposed {
+		s.mu.Unlock()
+		return nil
+	}
+	s.disposed = true
+	current := s.current
+	s.current = nil
+	s.mu.Unlock()
+	if current != nil {
+		return disposeErr(current)
+	}
+	return nil
+}
+
+func (s *disposableSlot) isDisposed() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.disposed
+}
+
+var _ ErrorDisposable = (*SerialDisposable)(nil)
+
+// SerialDisposable holds a single Disposable, disposing the previous one when it's set to a new one
+type SerialDisposable struct {
+	slot disposableSlot
+}
+
+// NewSerialDisposable creates a SerialDisposable holding d, which can be nil
+func NewSerialDisposable(d Disposable) *SerialDisposable {
+	return &SerialDisposable{slot: disposableSlot{current: d}}
+}
+
+// Set replaces the current Disposable by d and disposes the previous one.
+// If the SerialDisposable is already disposed, it disposes d and returns false
+func (s *SerialDisposable) Set(d Disposable) bool {
+	previous, ok := s.slot.swap(d)
+	if previous != nil {
+		previous.Dispose()
+	}
+	return ok
+}
+
+// Replace replaces the current Disposable by d without disposing the previous one.
+// If the SerialDisposable is already disposed, it disposes d and returns false
+func (s *SerialDisposable) Replace(d Disposable) bool {
+	_, ok := s.slot.swap(d)
+	return ok
+}
+
+// Get returns the current Disposable, or a disposed one once the SerialDisposable is disposed
+func (s *SerialDisposable) Get() Disposable {
+	return s.slot.get()
+}
+
+func (s *SerialDisposable) Dispose() {
+	reportDisposeErr(s.slot.dispose())
+}
+
+// DisposeErr disposes the current Disposable, and returns the error of its disposal
+func (s *SerialDisposable) DisposeErr() error {
+	return s.slot.dispose()
+}
+
+func (s *SerialDisposable) IsDisposed() bool {
+	return s.slot.isDisposed()
+}
+
+var _ ErrorDisposable = (*MultipleAssignmentDisposable)(nil)
+
+// MultipleAssignmentDisposable holds a single Disposable that can be replaced without disposing the previous one
+type MultipleAssignmentDisposable struct {
+	slot disposableSlot
+}
+
+// NewMultipleAssignmentDisposable creates a MultipleAssignmentDisposable holding d, which can be nil
+func NewMultipleAssignmentDisposable(d Disposable) *MultipleAssignmentDisposable {
+	return &MultipleAssignmentDisposable{slot: disposableSlot{current: d}}
+}
+
+// Set replaces the current Disposable by d without disposing the previous one.
+// If the MultipleAssignmentDisposable is already disposed, it disposes d and returns false
+func (m *MultipleAssignmentDisposable) Set(d Disposable) bool {
+	_, ok := m.slot.swap(d)
+	return ok
+}
+
+// Get returns the current Disposable, or a disposed one once the MultipleAssignmentDisposable is disposed
+func (m *MultipleAssignmentDisposable) Get() Disposable {
+	return m.slot.get()
+}
+
+func (m *MultipleAssignmentDisposable) Dispose() {
+	reportDisposeErr(m.slot.dispose())
+}
+
+// DisposeErr disposes the current Disposable, and returns the error of its disposal
+func (m *MultipleAssignmentDisposable) DisposeErr() error {
+	return m.slot.dispose()
+}
+
+func (m *MultipleAssignmentDisposable) IsDisposed() bool {
+	return m.slot.isDisposed()
+}
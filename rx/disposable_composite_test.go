@@ -0,0 +1,147 @@
+package rx
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompositeDisposable(t *testing.T) {
+	t.Run("CompositeDisposable_should_DisposeAllInReverseOrder", func(t *testing.T) {
+		var order []int
+		newDisposable := func(i int) Disposable {
+			return Disposables.FromFunc(func() { order = append(order, i) })
+		}
+		c := NewCompositeDisposable(newDisposable(1), newDisposable(2))
+		c.Add(newDisposable(3))
+		assert.Equal(t, 3, c.Size())
+		c.Dispose()
+		assert.True(t, c.IsDisposed())
+		assert.Equal(t, []int{3, 2, 1}, order)
+		assert.Equal(t, 0, c.Size())
+	})
+
+	t.Run("CompositeDisposable_should_DisposeDisposablesAddedAfterDisposal", func(t *testing.T) {
+		c := NewCompositeDisposable()
+		c.Dispose()
+		d := Disposables.Empty()
+		assert.False(t, c.Add(d))
+		assert.True(t, d.IsDisposed())
+	})
+
+	t.Run("CompositeDisposable_should_RemoveAndDispose", func(t *testing.T) {
+		d := Disposables.Empty()
+		c := NewCompositeDisposable(d)
+		assert.True(t, c.Remove(d))
+		assert.True(t, d.IsDisposed())
+		assert.False(t, c.Remove(d), "should not remove twice")
+		assert.Equal(t, 0, c.Size())
+	})
+
+	t.Run("CompositeDisposable_should_DeleteWithoutDisposing", func(t *testing.T) {
+		d := Disposables.Empty()
+		c := NewCompositeDisposable(d)
+		assert.True(t, c.Delete(d))
+		assert.False(t, d.IsDisposed())
+		c.Dispose()
+		assert.False(t, d.IsDisposed(), "should not dispose deleted disposables")
+	})
+
+	t.Run("CompositeDisposable_should_StayUsableAfterClear", func(t *testing.T) {
+		first, second := Disposables.Empty(), Disposables.Empty()
+		c := NewCompositeDisposable(first)
+		c.Clear()
+		assert.True(t, first.IsDisposed())
+		assert.False(t, c.IsDisposed())
+		assert.True(t, c.Add(second))
+		assert.False(t, second.IsDisposed())
+	})
+}
+
+func TestCompositeDisposable_DisposeErr(t *testing.T) {
+	t.Run("DisposeErr_should_AggregateTheErrorsOfTheChildren", func(t *testing.T) {
+		other := errors.New("other")
+		c := NewCompositeDisposable(
+			Disposables.FromCloser(&testCloser{err: errTest}),
+			Disposables.Empty(),
+			Disposables.FromCloser(&testCloser{err: other}),
+		)
+		err := c.DisposeErr()
+		var composite *CompositeError
+		if !assert.True(t, errors.As(err, &composite)) {
+			return
+		}
+		assert.Equal(t, []error{other, errTest}, composite.Errors(), "should dispose in LIFO order")
+		assert.True(t, errors.Is(err, errTest))
+		assert.NoError(t, c.DisposeErr(), "should only dispose once")
+	})
+
+	t.Run("Dispose_should_ReportTheErrorsOfTheChildren", func(t *testing.T) {
+		undeliverable := captureUndeliverableErrors(t)
+		c := NewCompositeDisposable(Disposables.FromCloser(&testCloser{err: errTest}))
+		c.Dispose()
+		assert.Equal(t, []error{errTest}, undeliverable())
+	})
+
+	t.Run("Clear_should_ReportTheErrorsOfTheChildren", func(t *testing.T) {
+		undeliverable := captureUndeliverableErrors(t)
+		c := NewCompositeDisposable(Disposables.FromCloser(&testCloser{err: errTest}))
+		c.Clear()
+		assert.Equal(t, []error{errTest}, undeliverable())
+	})
+
+	t.Run("SerialDisposable_should_ReturnTheErrorOfTheCurrentDisposable", func(t *testing.T) {
+		s := NewSerialDisposable(Disposables.FromCloser(&testCloser{err: errTest}))
+		assert.Equal(t, errTest, s.DisposeErr())
+	})
+}
+
+func TestSerialDisposable(t *testing.T) {
+	t.Run("Set_should_DisposeThePreviousDisposable", func(t *testing.T) {
+		first, second := Disposables.Empty(), Disposables.Empty()
+		s := NewSerialDisposable(first)
+		assert.True(t, s.Set(second))
+		assert.True(t, first.IsDisposed())
+		assert.Equal(t, second, s.Get())
+	})
+
+	t.Run("Replace_should_NotDisposeThePreviousDisposable", func(t *testing.T) {
+		first, second := Disposables.Empty(), Disposables.Empty()
+		s := NewSerialDisposable(first)
+		assert.True(t, s.Replace(second))
+		assert.False(t, first.IsDisposed())
+		assert.Equal(t, second, s.Get())
+	})
+
+	t.Run("Dispose_should_DisposeTheCurrentAndLaterDisposables", func(t *testing.T) {
+		first, second := Disposables.Empty(), Disposables.Empty()
+		s := NewSerialDisposable(first)
+		s.Dispose()
+		assert.True(t, s.IsDisposed())
+		assert.True(t, first.IsDisposed())
+		assert.False(t, s.Set(second))
+		assert.True(t, second.IsDisposed())
+		assert.True(t, s.Get().IsDisposed())
+	})
+}
+
+func TestMultipleAssignmentDisposable(t *testing.T) {
+	t.Run("Set_should_NotDisposeThePreviousDisposable", func(t *testing.T) {
+		first, second := Disposables.Empty(), Disposables.Empty()
+		m := NewMultipleAssignmentDisposable(first)
+		assert.True(t, m.Set(second))
+		assert.False(t, first.IsDisposed())
+		m.Dispose()
+		assert.True(t, second.IsDisposed())
+		assert.False(t, first.IsDisposed())
+	})
+
+	t.Run("Set_should_DisposeDisposablesSetAfterDisposal", func(t *testing.T) {
+		m := NewMultipleAssignmentDisposable(nil)
+		m.Dispose()
+		d := Disposables.Empty()
+		assert.False(t, m.Set(d))
+		assert.True(t, d.IsDisposed())
+	})
+}
@@ -0,0 +1,179 @@
+package rx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+	"unsafe"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisposableRef(t *testing.T) {
+	t.Run("Set_should_DisposeThePreviousDisposable", func(t *testing.T) {
+		var ref DisposableRef
+		first, second := Disposables.Empty(), Disposables.Empty()
+		assert.True(t, ref.Set(first))
+		assert.True(t, ref.Set(second))
+		assert.True(t, first.IsDisposed())
+		assert.Equal(t, second, ref.Get())
+	})
+
+	t.Run("SetOnce_should_RejectASecondDisposable", func(t *testing.T) {
+		var ref DisposableRef
+		first, second := Disposables.Empty(), Disposables.Empty()
+		assert.True(t, ref.SetOnce(first))
+		assert.False(t, ref.SetOnce(second))
+		assert.True(t, second.IsDisposed())
+		assert.False(t, first.IsDisposed())
+		assert.Equal(t, first, ref.Get())
+	})
+
+	t.Run("SetOnce_should_IgnoreNil", func(t *testing.T) {
+		var ref DisposableRef
+		assert.False(t, ref.SetOnce(nil))
+		assert.Nil(t, ref.Get())
+	})
+
+	t.Run("Replace_should_NotDisposeThePreviousDisposable", func(t *testing.T) {
+		var ref DisposableRef
+		first, second := Disposables.Empty(), Disposables.Empty()
+		ref.Set(first)
+		assert.True(t, ref.Replace(second))
+		assert.False(t, first.IsDisposed())
+		assert.Equal(t, second, ref.Get())
+	})
+
+	t.Run("Dispose_should_DisposeTheCurrentAndLaterDisposables", func(t *testing.T) {
+		var ref DisposableRef
+		first, second := Disposables.Empty(), Disposables.Empty()
+		ref.Set(first)
+		assert.True(t, ref.TryDispose())
+		assert.False(t, ref.TryDispose(), "should only dispose once")
+		assert.True(t, ref.IsDisposed())
+		assert.True(t, first.IsDisposed())
+		assert.False(t, ref.Set(second))
+		assert.True(t, second.IsDisposed())
+	})
+
+	t.Run("Dispose_should_WorkWithoutDisposable", func(t *testing.T) {
+		var ref DisposableRef
+		ref.Dispose()
+		assert.True(t, ref.IsDisposed())
+	})
+}
+
+func TestDisposableHelper(t *testing.T) {
+	t.Run("SetOnce_should_AcceptNilOnceSet", func(t *testing.T) {
+		var ptr unsafe.Pointer
+		d := Disposables.Empty()
+		assert.True(t, DisposableHelper.SetOnce(&ptr, &d))
+		assert.NotPanics(t, func() {
+			assert.False(t, DisposableHelper.SetOnce(&ptr, nil))
+		})
+	})
+}
+
+// captureUndeliverableErrors collects the undeliverable errors until the test ends
+func captureUndeliverableErrors(t *testing.T) func() []error {
+	var mu sync.Mutex
+	var errs []error
+	Plugins.SetErrorHandler(func(err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		errs = append(errs, err)
+	})
+	t.Cleanup(func() {
+		Plugins.SetErrorHandler(nil)
+	})
+	return func() []error {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]error(nil), errs...)
+	}
+}
+
+type testCloser struct {
+	closed int
+	err    error
+}
+
+func (c *testCloser) Close() error {
+	c.closed++
+	return c.err
+}
+
+func TestDisposables_FromCloser(t *testing.T) {
+	t.Run("DisposeErr_should_ReturnTheErrorOfClose", func(t *testing.T) {
+		closer := &testCloser{err: errTest}
+		d := Disposables.FromCloser(closer)
+		assert.Equal(t, errTest, d.DisposeErr())
+		assert.True(t, d.IsDisposed())
+		assert.NoError(t, d.DisposeErr(), "should only close once")
+		assert.Equal(t, 1, closer.closed)
+	})
+
+	t.Run("Dispose_should_ReportTheErrorOfClose", func(t *testing.T) {
+		undeliverable := captureUndeliverableErrors(t)
+		d := Disposables.FromCloser(&testCloser{err: errTest})
+		d.Dispose()
+		assert.Equal(t, []error{errTest}, undeliverable())
+	})
+}
+
+func TestCompositeError(t *testing.T) {
+	other := errors.New("other")
+	err := compositeErrorOf([]error{errTest, &CancelledError{Err: other}})
+	assert.EqualError(t, err, "2 errors occurred: test; cancelled after consuming 0 items: other")
+	assert.True(t, errors.Is(err, errTest))
+	assert.True(t, errors.Is(err, other))
+	var cancelled *CancelledError
+	assert.True(t, errors.As(err, &cancelled))
+	assert.Equal(t, errTest, compositeErrorOf([]error{errTest}), "should not wrap a single error")
+	assert.NoError(t, compositeErrorOf(nil))
+	assert.NoError(t, compositeErrorOf([]error{nil}), "should skip nil errors")
+
+	nested := compositeErrorOf([]error{err, other})
+	var composite *CompositeError
+	if assert.True(t, errors.As(nested, &composite)) {
+		assert.Len(t, composite.Errors(), 3, "should flatten nested composites")
+	}
+	assert.Equal(t, "3 errors occurred:\n\t1. test\n\t2. cancelled after consuming 0 items: other\n\t3. other",
+		fmt.Sprintf("%+v", nested))
+}
+
+func TestDisposables_FromContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	d := Disposables.FromContext(ctx, cancel)
+	assert.False(t, d.IsDisposed())
+	d.Dispose()
+	assert.True(t, d.IsDisposed())
+	assert.Equal(t, context.Canceled, ctx.Err())
+}
+
+func TestDisposables_DisposeOnDone(t *testing.T) {
+	t.Run("DisposeOnDone_should_DisposeOnceTheContextIsDone", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		disposed := make(chan struct{})
+		Disposables.DisposeOnDone(ctx, Disposables.FromFunc(func() { close(disposed) }))
+		cancel()
+		select {
+		case <-disposed:
+		case <-time.After(time.Second):
+			assert.Fail(t, "should dispose once the context is done")
+		}
+	})
+
+	t.Run("DisposeOnDone_should_DisposeWithTheReturnedDisposable", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		d := Disposables.Empty()
+		bound := Disposables.DisposeOnDone(ctx, d)
+		bound.Dispose()
+		assert.True(t, d.IsDisposed())
+		assert.True(t, bound.IsDisposed())
+	})
+}
@@ -0,0 +1,178 @@
+package rx
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+
+	"www.github.com/secretworry/rx-go/rx/fun"
+)
+
+// DistinctCache remembers the keys already seen by Distinct
+type DistinctCache interface {
+	// Add records the given key, and reports whether it was not remembered yet
+	Add(key interface{}) bool
+}
+
+// NewUnboundedDistinctCache remembers every key it has seen, its memory grows with the number of distinct keys
+func NewUnboundedDistinctCache() DistinctCache {
+	return &unboundedDistinctCache{
+		keys: make(map[interface{}]struct{}),
+	}
+}
+
+// NewLRUDistinctCache remembers at most capacity keys, forgetting the least recently seen one first
+func NewLRUDistinctCache(capacity int) DistinctCache {
+	if capacity <= 0 {
+		panic("capacity should be positive")
+	}
+	return &lruDistinctCache{
+		capacity: capacity,
+		entries:  make(map[interface{}]*list.Element),
+		order:    list.New(),
+	}
+}
+
+// NewExpiringDistinctCache forgets a key once it has not been seen for the given duration
+func NewExpiringDistinctCache(ttl time.Duration) DistinctCache {
+	if ttl <= 0 {
+		panic("ttl should be positive")
+	}
+	return &expiringDistinctCache{
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[interface{}]*list.Element),
+		order:   list.New(),
+	}
+}
+
+var _ DistinctCache = (*unboundedDistinctCache)(nil)
+
+type unboundedDistinctCache struct {
+	mu   sync.Mutex
+	keys map[interface{}]struct{}
+}
+
+func (c *unboundedDistinctCache) Add(key interface{}) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.keys[key]; ok {
+		return false
+	}
+	c.keys[key] = struct{}{}
+	return true
+}
+
+var _ DistinctCache = (*lruDistinctCache)(nil)
+
+type lruDistinctCache struct {
+	mu       sync.Mutex
+	capacity int
+	entries  map[interface{}]*list.Element
+	order    *list.List
+}
+
+func (c *lruDistinctCache) Add(key interface{}) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.entries[key]; ok {
+		c.order.MoveToBack(e)
+		return false
+	}
+	if c.order.Len() >= c.capacity {
+		oldest := c.order.Front()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value)
+	}
+	c.entries[key] = c.order.PushBack(key)
+	return true
+}
+
+var _ DistinctCache = (*expiringDistinctCache)(nil)
+
+type expiringEntry struct {
+	key      interface{}
+	lastSeen time.Time
+}
+
+type expiringDistinctCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	now     func() time.Time
+	entries map[interface{}]*list.Element
+	order   *list.List
+}
+
+func (c *expiringDistinctCache) Add(key interface{}) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.now()
+	// entries are ordered by the time they were last seen, so the expired ones are all at the front
+	for e := c.order.Front(); e != nil; e = c.order.Front() {
+		entry := e.Value.(*expiringEntry)
+		if now.Sub(entry.lastSeen) < c.ttl {
+			break
+		}
+		c.order.Remove(e)
+		delete(c.entries, entry.key)
+	}
+	if e, ok := c.entries[key]; ok {
+		e.Value.(*expiringEntry).lastSeen = now
+		c.order.MoveToBack(e)
+		return false
+	}
+	c.entries[key] = c.order.PushBack(&expiringEntry{key: key, lastSeen: now})
+	return true
+}
+
+// comparedDistinctCache remembers the keys Distinct compares with a DistinctComparer. They cannot be hashed, so a key
+// is compared with every remembered one, least recently seen first. capacity and ttl bound them like the LRU and
+// expiring caches, 0 meaning no bound
+type comparedDistinctCache struct {
+	comparer fun.BiCaller
+	capacity int
+	ttl      time.Duration
+	now      func() time.Time
+	order    *list.List
+}
+
+func newComparedDistinctCache(comparer fun.BiCaller, capacity int, ttl time.Duration) *comparedDistinctCache {
+	return &comparedDistinctCache{
+		comparer: comparer,
+		capacity: capacity,
+		ttl:      ttl,
+		now:      time.Now,
+		order:    list.New(),
+	}
+}
+
+// add records the given key, and reports whether it was not remembered yet
+func (c *comparedDistinctCache) add(ctx context.Context, key interface{}) (bool, error) {
+	now := c.now()
+	if c.ttl > 0 {
+		for e := c.order.Front(); e != nil; e = c.order.Front() {
+			if now.Sub(e.Value.(*expiringEntry).lastSeen) < c.ttl {
+				break
+			}
+			c.order.Remove(e)
+		}
+	}
+	for e := c.order.Front(); e != nil; e = e.Next() {
+		entry := e.Value.(*expiringEntry)
+		equal, err := c.comparer.Call(ctx, entry.key, key)
+		if err != nil {
+			return false, err
+		}
+		if equal.(bool) {
+			entry.lastSeen = now
+			c.order.MoveToBack(e)
+			return false, nil
+		}
+	}
+	if c.capacity > 0 && c.order.Len() >= c.capacity {
+		c.order.Remove(c.order.Front())
+	}
+	c.order.PushBack(&expiringEntry{key: key, lastSeen: now})
+	return true, nil
+}
@@ -0,0 +1,82 @@
+package rx
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"www.github.com/secretworry/rx-go/rx/fun"
+)
+
+func TestNewLRUDistinctCache(t *testing.T) {
+	t.Run("LRUDistinctCache_should_ForgetTheLeastRecentlySeenKey", func(t *testing.T) {
+		cache := NewLRUDistinctCache(2)
+		assert.True(t, cache.Add(1))
+		assert.True(t, cache.Add(2))
+		assert.False(t, cache.Add(1), "should remember 1")
+		assert.True(t, cache.Add(3), "should evict 2")
+		assert.False(t, cache.Add(1), "should remember 1")
+		assert.True(t, cache.Add(2), "should have forgotten 2")
+	})
+}
+
+func TestNewExpiringDistinctCache(t *testing.T) {
+	t.Run("ExpiringDistinctCache_should_ForgetKeysNotSeenWithinTTL", func(t *testing.T) {
+		now := time.Unix(0, 0)
+		cache := NewExpiringDistinctCache(time.Second).(*expiringDistinctCache)
+		cache.now = func() time.Time { return now }
+		assert.True(t, cache.Add(1))
+		assert.True(t, cache.Add(2))
+		now = now.Add(500 * time.Millisecond)
+		assert.False(t, cache.Add(1), "should remember 1 and refresh it")
+		now = now.Add(600 * time.Millisecond)
+		assert.False(t, cache.Add(1), "should remember the refreshed key")
+		assert.True(t, cache.Add(2), "should have forgotten 2")
+		assert.Len(t, cache.entries, 2)
+	})
+}
+
+func TestComparedDistinctCache(t *testing.T) {
+	sameLength, err := fun.BiCallerOf(func(a, b []int) bool { return len(a) == len(b) })
+	if !assert.NoError(t, err) {
+		return
+	}
+	ctx := context.Background()
+
+	t.Run("ComparedDistinctCache_should_ForgetTheLeastRecentlySeenKey", func(t *testing.T) {
+		cache := newComparedDistinctCache(sameLength, 2, 0)
+		for _, tt := range []struct {
+			key    []int
+			expect bool
+		}{
+			{key: []int{1}, expect: true},
+			{key: []int{1, 2}, expect: true},
+			{key: []int{3}, expect: false},
+			{key: []int{1, 2, 3}, expect: true},
+			{key: []int{4}, expect: false},
+			{key: []int{5, 6}, expect: true},
+		} {
+			added, err := cache.add(ctx, tt.key)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expect, added, "adding %v", tt.key)
+		}
+		assert.Equal(t, 2, cache.order.Len())
+	})
+
+	t.Run("ComparedDistinctCache_should_ForgetKeysNotSeenWithinTTL", func(t *testing.T) {
+		now := time.Unix(0, 0)
+		cache := newComparedDistinctCache(sameLength, 0, time.Second)
+		cache.now = func() time.Time { return now }
+		added, _ := cache.add(ctx, []int{1})
+		assert.True(t, added)
+		now = now.Add(500 * time.Millisecond)
+		added, _ = cache.add(ctx, []int{2})
+		assert.False(t, added, "should remember the key and refresh it")
+		now = now.Add(time.Second)
+		added, _ = cache.add(ctx, []int{3})
+		assert.True(t, added, "should have forgotten the key")
+		assert.Equal(t, 1, cache.order.Len())
+	})
+}
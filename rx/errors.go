@@ -1,17 +1,234 @@
 package rx
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"io"
+	"runtime/debug"
+	"strings"
+)
+
+// ErrGroupAlreadySubscribed is signaled to any observer subscribing to a GroupedObservable that already has one
+var ErrGroupAlreadySubscribed = errors.New("only one observer is allowed for a GroupedObservable")
+
+// ErrNoSuchElement is signaled by the operators requiring an item that the source does not emit
+var ErrNoSuchElement = errors.New("no such element")
+
+// ErrSequenceContainsMoreThanOne is signaled by Single when the source emits more than one item
+var ErrSequenceContainsMoreThanOne = errors.New("sequence contains more than one element")
+
+// ErrProtocolViolation is reported by SafeObserver when a source violates the Observable contract
+var ErrProtocolViolation = errors.New("observable protocol violation")
+
+// ErrNonPositiveRequest is signaled to a Subscriber requesting zero or a negative number of items, as required by
+// the rule 3.9 of Reactive Streams
+var ErrNonPositiveRequest = errors.New("the number of items requested should be positive")
+
+// ErrMissingBackpressure matches the *MissingBackpressureError signaled by a Flowable receiving more items than its
+// Subscriber requested, when it's set to signal an error rather than buffering or dropping them
+var ErrMissingBackpressure = errors.New("could not emit an item due to lack of requests")
 
 var _ error = (*PanicError)(nil)
 
+// PanicError is signaled in place of a panic recovered by the library, along with the stack of the panic.
+// It unwraps to the panic value if it's an error. It's always handled through a pointer, so the stack is shared
+// rather than copied along with the error, and comparing two of them with == doesn't panic on the stack slice:
+// match it with errors.As and a *PanicError target. Unlike earlier versions, which signaled a PanicError value,
+// err.(PanicError) never succeeds
 type PanicError struct {
-	msg interface{}
+	value interface{}
+	stack []byte
+}
+
+func (p *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", p.value)
+}
+
+// Value returns the value the function panicked with
+func (p *PanicError) Value() interface{} {
+	return p.value
+}
+
+// Stack returns the stack of the goroutine when the panic was recovered
+func (p *PanicError) Stack() []byte {
+	return p.stack
+}
+
+func (p *PanicError) Unwrap() error {
+	if err, ok := p.value.(error); ok {
+		return err
+	}
+	return nil
+}
+
+// ErrPanic returns a *PanicError, rather than a PanicError value, of the given panic value. It should be called by
+// the deferred function recovering the panic so that the stack points to where it happened
+func ErrPanic(value interface{}) error {
+	return &PanicError{value: value, stack: debug.Stack()}
+}
+
+var _ error = (*CancelledError)(nil)
+
+// CancelledError is returned by the blocking operators when their context is done before the source terminates.
+// It unwraps to the error of the context
+type CancelledError struct {
+	Err      error
+	Consumed int
+}
+
+func (c *CancelledError) Error() string {
+	return fmt.Sprintf("cancelled after consuming %d items: %v", c.Consumed, c.Err)
+}
+
+func (c *CancelledError) Unwrap() error {
+	return c.Err
+}
+
+var _ error = (*CompositeError)(nil)
+
+// CompositeError aggregates several errors, errors.Is and errors.As match any of them.
+// It's formatted on one line by %v, and one error per line by %+v
+type CompositeError struct {
+	errs []error
+}
+
+// compositeErrorOf returns nil if there is no error, the error itself if there is only one, or a *CompositeError.
+// The errors of a nested *CompositeError are flattened
+func compositeErrorOf(errs []error) error {
+	var flattened []error
+	for _, err := range errs {
+		if composite, ok := err.(*CompositeError); ok {
+			flattened = append(flattened, composite.errs...)
+		} else if err != nil {
+			flattened = append(flattened, err)
+		}
+	}
+	switch len(flattened) {
+	case 0:
+		return nil
+	case 1:
+		return flattened[0]
+	default:
+		return &CompositeError{errs: flattened}
+	}
+}
+
+// Errors returns the aggregated errors
+func (c *CompositeError) Errors() []error {
+	return append([]error(nil), c.errs...)
+}
+
+func (c *CompositeError) Error() string {
+	messages := make([]string, len(c.errs))
+	for i, err := range c.errs {
+		messages[i] = err.Error()
+	}
+	return fmt.Sprintf("%d errors occurred: %s", len(c.errs), strings.Join(messages, "; "))
+}
+
+func (c *CompositeError) Unwrap() []error {
+	return c.errs
+}
+
+func (c *CompositeError) Format(s fmt.State, verb rune) {
+	switch {
+	case verb == 'q':
+		_, _ = fmt.Fprintf(s, "%q", c.Error())
+		return
+	case verb != 'v' || !s.Flag('+'):
+		_, _ = io.WriteString(s, c.Error())
+		return
+	}
+	_, _ = fmt.Fprintf(s, "%d errors occurred:", len(c.errs))
+	for i, err := range c.errs {
+		_, _ = fmt.Fprintf(s, "\n\t%d. %+v", i+1, err)
+	}
+}
+
+var _ error = (*AssemblyTrace)(nil)
+
+// AssemblyTrace decorates the errors passing through the Observables assembled while assembly tracking is enabled,
+// see Plugins.SetAssemblyTracking. It has the same message as the error it wraps, %+v prints the trace as well
+type AssemblyTrace struct {
+	err   error
+	sites []AssemblySite
+}
+
+// withAssemblySite returns err decorated with the given site appended to its trace
+func withAssemblySite(err error, site AssemblySite) error {
+	trace, ok := err.(*AssemblyTrace)
+	if !ok {
+		return &AssemblyTrace{err: err, sites: []AssemblySite{site}}
+	}
+	if last := trace.sites[len(trace.sites)-1]; last == site {
+		// the operators implemented by several Observables are tracked once
+		return trace
+	}
+	return &AssemblyTrace{err: trace.err, sites: append(trace.Sites(), site)}
+}
+
+// Sites returns the sites of the Observables the error went through, from the one emitting it to the one closest
+// to the subscriber
+func (a *AssemblyTrace) Sites() []AssemblySite {
+	return append([]AssemblySite(nil), a.sites...)
+}
+
+func (a *AssemblyTrace) Error() string {
+	return a.err.Error()
+}
+
+func (a *AssemblyTrace) Unwrap() error {
+	return a.err
+}
+
+func (a *AssemblyTrace) Format(s fmt.State, verb rune) {
+	switch {
+	case verb == 'q':
+		_, _ = fmt.Fprintf(s, "%q", a.Error())
+		return
+	case verb != 'v' || !s.Flag('+'):
+		_, _ = io.WriteString(s, a.Error())
+		return
+	}
+	_, _ = fmt.Fprintf(s, "%+v\nassembly trace:", a.err)
+	for _, site := range a.sites {
+		_, _ = fmt.Fprintf(s, "\n\t%s", site)
+	}
+}
+
+var _ error = (*CheckpointError)(nil)
+
+// CheckpointError is signaled in place of an error going through a Checkpoint, it unwraps to that error
+type CheckpointError struct {
+	Description string
+	Site        AssemblySite
+	Err         error
+}
+
+func (c *CheckpointError) Error() string {
+	return fmt.Sprintf("checkpoint %q at %s:%d: %v", c.Description, c.Site.File, c.Site.Line, c.Err)
+}
+
+func (c *CheckpointError) Unwrap() error {
+	return c.Err
+}
+
+var _ error = (*MissingBackpressureError)(nil)
+
+// MissingBackpressureError is signaled by a Flowable receiving an item it can neither emit nor buffer, Capacity
+// being the size of its full buffer, or 0 if it doesn't buffer the items beyond the demand.
+// errors.Is matches it with ErrMissingBackpressure
+type MissingBackpressureError struct {
+	Capacity int
 }
 
-func (p PanicError) Error() string {
-	return fmt.Sprintf("panic: %v", p.msg)
+func (m *MissingBackpressureError) Error() string {
+	if m.Capacity > 0 {
+		return fmt.Sprintf("%v: the buffer of %d items is full", ErrMissingBackpressure, m.Capacity)
+	}
+	return ErrMissingBackpressure.Error()
 }
 
-func ErrPanic(msg interface{}) error {
-	return PanicError{msg: msg}
+func (m *MissingBackpressureError) Is(target error) bool {
+	return target == ErrMissingBackpressure
 }
@@ -0,0 +1,34 @@
+package rx
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPanicError(t *testing.T) {
+	t.Run("PanicError_should_CaptureTheStackOfThePanic", func(t *testing.T) {
+		err := Create(func(ctx context.Context, ob ObservableEmitter) {
+			panic("boom")
+		}).BlockingForEach(context.Background(), func(interface{}) {})
+		var panicErr *PanicError
+		if !assert.True(t, errors.As(err, &panicErr), "should signal a *PanicError") {
+			return
+		}
+		assert.EqualError(t, err, "panic: boom")
+		assert.Equal(t, "boom", panicErr.Value())
+		assert.Contains(t, string(panicErr.Stack()), "TestPanicError", "should capture where it panicked")
+		assert.NoError(t, errors.Unwrap(panicErr), "should not unwrap a non error value")
+	})
+
+	t.Run("PanicError_should_WrapErrorValues", func(t *testing.T) {
+		err := Create(func(ctx context.Context, ob ObservableEmitter) {
+			panic(errTest)
+		}).BlockingForEach(context.Background(), func(interface{}) {})
+		var panicErr *PanicError
+		assert.True(t, errors.As(err, &panicErr), "should tell a panic from an error")
+		assert.True(t, errors.Is(err, errTest), "should unwrap to the panic value")
+	})
+}
@@ -0,0 +1,88 @@
+package rx
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"reflect"
+)
+
+// Subscriber receives the signals of a Publisher, it only receives as many items as it requested to the
+// Subscription passed to OnSubscribe
+type Subscriber interface {
+	Type() reflect.Type
+	OnSubscribe(subscription Subscription)
+	OnNext(ctx context.Context, msg interface{})
+	OnError(ctx context.Context, err error)
+	OnComplete(ctx context.Context)
+}
+
+// Subscription is the link between a Publisher and a Subscriber, through which the Subscriber signals its demand.
+// Both methods can be called from any goroutine, including from within the signals of the Subscriber
+type Subscription interface {
+	// Request adds n items to the demand of the Subscriber. A demand reaching math.MaxInt64 is unbounded.
+	// Requesting n <= 0 cancels the Subscription and signals ErrNonPositiveRequest
+	Request(n int64)
+	// Cancel asks the Publisher to stop signaling, it's idempotent
+	Cancel()
+}
+
+// Publisher emits items to its Subscribers as they request them, following the Reactive Streams specification:
+// OnSubscribe comes first, then at most as many OnNext as requested, then at most one of OnError and OnComplete,
+// none of them being called concurrently
+type Publisher interface {
+	Type() reflect.Type
+	Subscribe(ctx context.Context, s Subscriber)
+}
+
+type FlowableOperators interface {
+	ToObservable() Observable
+	OnBackpressureBuffer(capacity int, onOverflow func(), strategy BackpressureOverflowStrategy) Flowable
+	OnBackpressureDrop(onDrop interface{}) Flowable
+	OnBackpressureLatest() Flowable
+}
+
+type Flowable interface {
+	FlowableOperators
+	Publisher
+}
+
+// BaseFlowable implements the operators of Flowable upon Self, like BaseObservable does for Observable
+type BaseFlowable struct {
+	Self func() Publisher
+}
+
+func (b BaseFlowable) Type() reflect.Type {
+	return b.Self().Type()
+}
+
+// Subscribe subscribes the given subscriber through the Flowable subscribe hook, like the Flowables of the package
+// subscribe to their sources. A type outside of the package embedding BaseFlowable should implement its own Subscribe,
+// otherwise an error is signaled to the subscriber
+func (b BaseFlowable) Subscribe(ctx context.Context, s Subscriber) {
+	self := b.Self()
+	if _, ok := self.(flowableActualSubscriber); !ok {
+		// subscribeFlowable would call self.Subscribe, which is this very method
+		s.OnSubscribe(emptySubscription{})
+		s.OnError(ctx, fmt.Errorf("%T embeds BaseFlowable without implementing Subscribe", self))
+		return
+	}
+	subscribeFlowable(ctx, self, s)
+}
+
+var _ Subscription = emptySubscription{}
+
+// emptySubscription is given to a Subscriber which is terminated right away
+type emptySubscription struct{}
+
+func (emptySubscription) Request(n int64) {}
+
+func (emptySubscription) Cancel() {}
+
+// addDemand adds n to the demand, capping it to math.MaxInt64 which stands for an unbounded demand
+func addDemand(demand, n int64) int64 {
+	if demand == math.MaxInt64 || n > math.MaxInt64-demand {
+		return math.MaxInt64
+	}
+	return demand + n
+}
@@ -0,0 +1,46 @@
+package rx
+
+import (
+	"context"
+	"reflect"
+)
+
+var _ Flowable = (*FlowableOnAssembly)(nil)
+
+// FlowableOnAssembly decorates the errors of the Flowable it wraps with the site where it was assembled
+type FlowableOnAssembly struct {
+	BaseFlowable
+	source Flowable
+	site   AssemblySite
+}
+
+func (f *FlowableOnAssembly) Init() *FlowableOnAssembly {
+	f.Self = func() Publisher {
+		return f
+	}
+	return f
+}
+
+func (f *FlowableOnAssembly) Type() reflect.Type {
+	return f.source.Type()
+}
+
+// Site returns where the wrapped Flowable was assembled
+func (f *FlowableOnAssembly) Site() AssemblySite {
+	return f.site
+}
+
+func (f *FlowableOnAssembly) subscribeActual(ctx context.Context, s Subscriber) {
+	subscribeFlowable(ctx, f.source, &assemblySubscriber{Subscriber: s, site: f.site})
+}
+
+var _ Subscriber = (*assemblySubscriber)(nil)
+
+type assemblySubscriber struct {
+	Subscriber
+	site AssemblySite
+}
+
+func (s *assemblySubscriber) OnError(ctx context.Context, err error) {
+	s.Subscriber.OnError(ctx, withAssemblySite(err, s.site))
+}
@@ -0,0 +1,295 @@
+package rx
+
+import (
+	"context"
+	"math"
+	"reflect"
+	"sync"
+	"sync/atomic"
+
+	"www.github.com/secretworry/rx-go/rx/fun"
+)
+
+// BackpressureOverflowStrategy tells OnBackpressureBuffer what to do with an item arriving while its buffer is full
+type BackpressureOverflowStrategy int
+
+const (
+	// BackpressureOverflowError cancels the source and signals a *MissingBackpressureError right away, discarding the
+	// buffered items
+	BackpressureOverflowError BackpressureOverflowStrategy = iota
+	// BackpressureOverflowDropOldest drops the oldest item of the buffer to make room for the new one
+	BackpressureOverflowDropOldest
+	// BackpressureOverflowDropLatest drops the new item
+	BackpressureOverflowDropLatest
+)
+
+// OnBackpressureBuffer requests all the items of the source, and buffers up to capacity items beyond the demand of
+// the Subscriber, or all of them if capacity <= 0. When the buffer is full, onOverflow, if not nil, is called and
+// the item is handled according to strategy
+func (b BaseFlowable) OnBackpressureBuffer(capacity int, onOverflow func(), strategy BackpressureOverflowStrategy) Flowable {
+	policy := backpressurePolicy{capacity: int64(capacity), overflow: strategy}
+	if capacity <= 0 {
+		policy.capacity = -1
+	}
+	if onOverflow != nil {
+		policy.onOverflow = func(ctx context.Context, item interface{}) error {
+			onOverflow()
+			return nil
+		}
+	}
+	return newFlowableOnBackpressure(b.Self(), policy)
+}
+
+// OnBackpressureDrop requests all the items of the source, and drops those the Subscriber did not request yet.
+// onDrop, if not nil, is shaped like func([ctx context.Context,] item T) [error] and called with every dropped item,
+// an error returned by onDrop cancels the source and is signaled
+func (b BaseFlowable) OnBackpressureDrop(onDrop interface{}) Flowable {
+	source := b.Self()
+	policy := backpressurePolicy{overflow: BackpressureOverflowDropLatest}
+	if onDrop != nil {
+		runner, err := fun.RunnerOf(onDrop)
+		if err != nil {
+			return errorObservable(source.Type(), err).ToFlowable(BackpressureMissing)
+		}
+		policy.onOverflow = runner.Run
+	}
+	return newFlowableOnBackpressure(source, policy)
+}
+
+// OnBackpressureLatest requests all the items of the source, and keeps the latest one the Subscriber did not
+// request yet, dropping the previous one
+func (b BaseFlowable) OnBackpressureLatest() Flowable {
+	return newFlowableOnBackpressure(b.Self(), backpressurePolicy{
+		capacity: 1,
+		overflow: BackpressureOverflowDropOldest,
+	})
+}
+
+func newFlowableOnBackpressure(source Publisher, policy backpressurePolicy) Flowable {
+	return onFlowableAssembly((&FlowableOnBackpressure{
+		source: source,
+		policy: policy,
+	}).Init())
+}
+
+var _ Flowable = (*FlowableOnBackpressure)(nil)
+
+type FlowableOnBackpressure struct {
+	BaseFlowable
+	source Publisher
+	policy backpressurePolicy
+}
+
+func (f *FlowableOnBackpressure) Init() *FlowableOnBackpressure {
+	f.Self = func() Publisher {
+		return f
+	}
+	return f
+}
+
+func (f *FlowableOnBackpressure) Type() reflect.Type {
+	return f.source.Type()
+}
+
+func (f *FlowableOnBackpressure) subscribeActual(ctx context.Context, s Subscriber) {
+	o := newBackpressureObserver(ctx, s, f.policy)
+	s.OnSubscribe(o)
+	if o.upstream.IsDisposed() {
+		return
+	}
+	subscribeFlowable(ctx, f.source, &backpressureSubscriber{o})
+}
+
+var _ Subscriber = (*backpressureSubscriber)(nil)
+
+// backpressureSubscriber requests all the items of a Publisher to apply a backpressurePolicy to them
+type backpressureSubscriber struct {
+	*backpressureObserver
+}
+
+func (s *backpressureSubscriber) OnSubscribe(subscription Subscription) {
+	if s.upstream.SetOnce(&subscriptionDisposable{subscription: subscription}) {
+		subscription.Request(math.MaxInt64)
+	}
+}
+
+// backpressurePolicy tells what to do with the items beyond the demand of a Subscriber
+type backpressurePolicy struct {
+	// capacity is the number of items kept beyond the demand, or -1 for unbounded
+	capacity int64
+	overflow BackpressureOverflowStrategy
+	// onOverflow, if not nil, is called with the item dropped, or with the item that could not be emitted before
+	// signaling an error. The error it returns cancels the source and is signaled right away
+	onOverflow func(ctx context.Context, item interface{}) error
+	// ignoreDemand emits the items as they come, regardless of the demand
+	ignoreDemand bool
+}
+
+var _ Observer = (*backpressureObserver)(nil)
+var _ Subscription = (*backpressureObserver)(nil)
+
+// backpressureObserver queues the signals of the source under the lock, applying the policy to the items beyond
+// the demand, and the goroutine winning the drain loop emits them as they are requested
+type backpressureObserver struct {
+	actual   Subscriber
+	ctx      context.Context
+	policy   backpressurePolicy
+	upstream DisposableRef
+	wip      int32
+
+	mu sync.Mutex
+	// requested is the demand left once the queued items are emitted
+	requested int64
+	queue     []serializedSignal
+	terminal  *serializedSignal
+	// stopped is set once cancelled or terminated, nothing is emitted afterwards
+	stopped bool
+}
+
+func newBackpressureObserver(ctx context.Context, actual Subscriber, policy backpressurePolicy) *backpressureObserver {
+	o := &backpressureObserver{
+		actual: actual,
+		ctx:    ctx,
+		policy: policy,
+	}
+	if policy.ignoreDemand {
+		o.requested = math.MaxInt64
+	}
+	return o
+}
+
+func (o *backpressureObserver) Type() reflect.Type {
+	return o.actual.Type()
+}
+
+func (o *backpressureObserver) OnSubscribe(disposable Disposable) {
+	o.upstream.SetOnce(disposable)
+}
+
+func (o *backpressureObserver) OnNext(ctx context.Context, msg interface{}) {
+	sig := serializedSignal{ctx: ctx, signal: signal{value: msg}}
+	o.mu.Lock()
+	if o.stopped || o.terminal != nil {
+		o.mu.Unlock()
+		return
+	}
+	beyond := int64(len(o.queue)) - o.requested
+	if o.policy.capacity < 0 || beyond < o.policy.capacity {
+		o.queue = append(o.queue, sig)
+		o.mu.Unlock()
+		o.drain()
+		return
+	}
+	dropped := msg
+	if o.policy.overflow == BackpressureOverflowDropOldest && beyond > 0 {
+		// the items up to the demand are about to be emitted, the oldest one beyond it is dropped
+		oldest := int(o.requested)
+		dropped = o.queue[oldest].value
+		copy(o.queue[oldest:], o.queue[oldest+1:])
+		o.queue[len(o.queue)-1] = sig
+	}
+	o.mu.Unlock()
+	if o.policy.onOverflow != nil {
+		if err := o.policy.onOverflow(ctx, dropped); err != nil {
+			o.fail(ctx, err)
+			return
+		}
+	}
+	if o.policy.overflow == BackpressureOverflowError {
+		o.fail(ctx, &MissingBackpressureError{Capacity: int(o.policy.capacity)})
+	}
+}
+
+func (o *backpressureObserver) OnError(ctx context.Context, err error) {
+	o.terminate(serializedSignal{ctx: ctx, signal: signal{err: err, done: true}})
+}
+
+func (o *backpressureObserver) OnComplete(ctx context.Context) {
+	o.terminate(serializedSignal{ctx: ctx, signal: signal{done: true}})
+}
+
+func (o *backpressureObserver) terminate(sig serializedSignal) {
+	o.mu.Lock()
+	if o.stopped || o.terminal != nil {
+		o.mu.Unlock()
+		return
+	}
+	o.terminal = &sig
+	o.mu.Unlock()
+	o.drain()
+}
+
+// fail cancels the source, and signals err as soon as possible, discarding the queued items
+func (o *backpressureObserver) fail(ctx context.Context, err error) {
+	o.mu.Lock()
+	if !o.stopped {
+		o.queue = nil
+		o.terminal = &serializedSignal{ctx: ctx, signal: signal{err: err, done: true}}
+	}
+	o.mu.Unlock()
+	o.upstream.Dispose()
+	o.drain()
+}
+
+func (o *backpressureObserver) Request(n int64) {
+	if n <= 0 {
+		o.fail(o.ctx, ErrNonPositiveRequest)
+		return
+	}
+	o.mu.Lock()
+	o.requested = addDemand(o.requested, n)
+	o.mu.Unlock()
+	o.drain()
+}
+
+func (o *backpressureObserver) Cancel() {
+	o.mu.Lock()
+	o.stopped = true
+	o.queue = nil
+	o.mu.Unlock()
+	o.upstream.Dispose()
+}
+
+// drain emits the queued signals as long as they are requested. Request being called from within OnNext only
+// increments wip, so the recursion is bounded as required by the rule 3.3 of Reactive Streams
+func (o *backpressureObserver) drain() {
+	if atomic.AddInt32(&o.wip, 1) != 1 {
+		return
+	}
+	for {
+		for {
+			o.mu.Lock()
+			if o.stopped {
+				o.mu.Unlock()
+				return
+			}
+			if len(o.queue) > 0 && o.requested > 0 {
+				sig := o.queue[0]
+				o.queue[0] = serializedSignal{}
+				o.queue = o.queue[1:]
+				if o.requested != math.MaxInt64 {
+					o.requested--
+				}
+				o.mu.Unlock()
+				o.actual.OnNext(sig.ctx, sig.value)
+				continue
+			}
+			if o.terminal != nil && len(o.queue) == 0 {
+				o.stopped = true
+				terminal := *o.terminal
+				o.mu.Unlock()
+				if terminal.err != nil {
+					o.actual.OnError(terminal.ctx, terminal.err)
+				} else {
+					o.actual.OnComplete(terminal.ctx)
+				}
+				return
+			}
+			o.mu.Unlock()
+			break
+		}
+		if atomic.AddInt32(&o.wip, -1) == 0 {
+			return
+		}
+	}
+}
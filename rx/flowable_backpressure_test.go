@@ -0,0 +1,103 @@
+package rx
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBaseFlowable_OnBackpressure(t *testing.T) {
+	ctx := context.Background()
+	// source ignores the demand of its Subscribers
+	source := Just(1, 2, 3, 4, 5).ToFlowable(BackpressureMissing)
+
+	t.Run("BackpressureMissing_should_IgnoreTheDemand", func(t *testing.T) {
+		s := newTCKSubscriber(1)
+		source.Subscribe(ctx, s)
+		assert.Equal(t, []interface{}{1, 2, 3, 4, 5}, s.Items())
+		assert.True(t, s.Completed())
+	})
+
+	t.Run("OnBackpressureBuffer_should_BufferUpToTheDemand", func(t *testing.T) {
+		s := newTCKSubscriber(1)
+		source.OnBackpressureBuffer(0, nil, BackpressureOverflowError).Subscribe(ctx, s)
+		assert.Equal(t, []interface{}{1}, s.Items())
+		s.Subscription().Request(4)
+		assert.Equal(t, []interface{}{1, 2, 3, 4, 5}, s.Items())
+		assert.True(t, s.Completed())
+	})
+
+	t.Run("OnBackpressureBuffer_should_SignalAMissingBackpressureErrorOnOverflow", func(t *testing.T) {
+		overflows := 0
+		s := newTCKSubscriber(1)
+		source.OnBackpressureBuffer(2, func() { overflows++ }, BackpressureOverflowError).Subscribe(ctx, s)
+		assert.Equal(t, []interface{}{1}, s.Items(), "should discard the buffered items")
+		var missing *MissingBackpressureError
+		if assert.True(t, errors.As(s.Err(), &missing)) {
+			assert.Equal(t, 2, missing.Capacity)
+		}
+		assert.EqualError(t, s.Err(), "could not emit an item due to lack of requests: the buffer of 2 items is full")
+		assert.Equal(t, 1, overflows)
+	})
+
+	t.Run("OnBackpressureBuffer_should_DropTheOldestOnOverflow", func(t *testing.T) {
+		overflows := 0
+		s := newTCKSubscriber(1)
+		source.OnBackpressureBuffer(2, func() { overflows++ }, BackpressureOverflowDropOldest).Subscribe(ctx, s)
+		s.Subscription().Request(5)
+		assert.Equal(t, []interface{}{1, 4, 5}, s.Items())
+		assert.True(t, s.Completed())
+		assert.Equal(t, 2, overflows)
+	})
+
+	t.Run("OnBackpressureBuffer_should_DropTheLatestOnOverflow", func(t *testing.T) {
+		s := newTCKSubscriber(1)
+		source.OnBackpressureBuffer(2, nil, BackpressureOverflowDropLatest).Subscribe(ctx, s)
+		s.Subscription().Request(5)
+		assert.Equal(t, []interface{}{1, 2, 3}, s.Items())
+		assert.True(t, s.Completed())
+	})
+
+	t.Run("OnBackpressureDrop_should_DropTheItemsNotRequested", func(t *testing.T) {
+		var dropped []int
+		s := newTCKSubscriber(2)
+		source.OnBackpressureDrop(func(item int) {
+			dropped = append(dropped, item)
+		}).Subscribe(ctx, s)
+		assert.Equal(t, []interface{}{1, 2}, s.Items())
+		assert.Equal(t, []int{3, 4, 5}, dropped)
+		assert.True(t, s.Completed())
+	})
+
+	t.Run("OnBackpressureDrop_should_SignalTheErrorOfOnDrop", func(t *testing.T) {
+		s := newTCKSubscriber(1)
+		source.OnBackpressureDrop(func(item int) error {
+			return errTest
+		}).Subscribe(ctx, s)
+		assert.Equal(t, []interface{}{1}, s.Items())
+		assert.Equal(t, errTest, s.Err())
+	})
+
+	t.Run("OnBackpressureDrop_should_RejectAnInvalidOnDrop", func(t *testing.T) {
+		s := newTCKSubscriber(1)
+		source.OnBackpressureDrop(1).Subscribe(ctx, s)
+		assert.Error(t, s.Err())
+	})
+
+	t.Run("OnBackpressureLatest_should_KeepTheLatestItem", func(t *testing.T) {
+		s := newTCKSubscriber(1)
+		source.OnBackpressureLatest().Subscribe(ctx, s)
+		assert.Equal(t, []interface{}{1}, s.Items())
+		s.Subscription().Request(5)
+		assert.Equal(t, []interface{}{1, 5}, s.Items())
+		assert.True(t, s.Completed())
+	})
+
+	t.Run("OnBackpressure_should_KeepTheTypeOfTheSource", func(t *testing.T) {
+		assert.Equal(t, intType, source.OnBackpressureBuffer(0, nil, BackpressureOverflowError).Type())
+		assert.Equal(t, intType, source.OnBackpressureDrop(nil).Type())
+		assert.Equal(t, intType, source.OnBackpressureLatest().Type())
+	})
+}
@@ -0,0 +1,155 @@
+package rx
+
+import (
+	"context"
+	"math"
+	"reflect"
+	"sync/atomic"
+)
+
+// BackpressureStrategy tells a Flowable converted from an Observable what to do with the items its Subscriber did
+// not request yet
+type BackpressureStrategy int
+
+const (
+	// BackpressureBuffer buffers all the items until they are requested
+	BackpressureBuffer BackpressureStrategy = iota
+	// BackpressureDrop drops the items that are not requested yet
+	BackpressureDrop
+	// BackpressureLatest keeps the latest item that is not requested yet, dropping the previous one
+	BackpressureLatest
+	// BackpressureError cancels the source and signals a *MissingBackpressureError on the first item not requested
+	// yet
+	BackpressureError
+	// BackpressureMissing emits the items as they come regardless of the demand, leaving it to the downstream
+	// operators such as OnBackpressureBuffer
+	BackpressureMissing
+)
+
+// policy returns the backpressurePolicy implementing the strategy
+func (s BackpressureStrategy) policy() backpressurePolicy {
+	switch s {
+	case BackpressureDrop:
+		return backpressurePolicy{overflow: BackpressureOverflowDropLatest}
+	case BackpressureLatest:
+		return backpressurePolicy{capacity: 1, overflow: BackpressureOverflowDropOldest}
+	case BackpressureError:
+		return backpressurePolicy{overflow: BackpressureOverflowError}
+	case BackpressureMissing:
+		return backpressurePolicy{capacity: -1, ignoreDemand: true}
+	default:
+		return backpressurePolicy{capacity: -1}
+	}
+}
+
+// ToFlowable converts the Observable to a Flowable, handling the items emitted beyond the demand of each Subscriber
+// with the given strategy
+func (b BaseObservable) ToFlowable(strategy BackpressureStrategy) Flowable {
+	return onFlowableAssembly((&FlowableFromObservable{
+		source:   b.Self(),
+		strategy: strategy,
+	}).Init())
+}
+
+var _ Flowable = (*FlowableFromObservable)(nil)
+
+type FlowableFromObservable struct {
+	BaseFlowable
+	source   ObservableSource
+	strategy BackpressureStrategy
+}
+
+func (f *FlowableFromObservable) Init() *FlowableFromObservable {
+	f.Self = func() Publisher {
+		return f
+	}
+	return f
+}
+
+func (f *FlowableFromObservable) Type() reflect.Type {
+	return f.source.Type()
+}
+
+func (f *FlowableFromObservable) subscribeActual(ctx context.Context, s Subscriber) {
+	o := newBackpressureObserver(ctx, s, f.strategy.policy())
+	s.OnSubscribe(o)
+	if o.upstream.IsDisposed() {
+		return
+	}
+	subscribe(ctx, f.source, o)
+}
+
+// ToObservable converts the Flowable to an Observable, requesting all the items of the Flowable at once
+func (b BaseFlowable) ToObservable() Observable {
+	return onAssembly((&ObservableFromPublisher{
+		source: b.Self(),
+	}).Init())
+}
+
+var _ Observable = (*ObservableFromPublisher)(nil)
+
+type ObservableFromPublisher struct {
+	BaseObservable
+	source Publisher
+}
+
+func (o *ObservableFromPublisher) Init() *ObservableFromPublisher {
+	o.Self = func() ObservableSource {
+		return o
+	}
+	return o
+}
+
+func (o *ObservableFromPublisher) Type() reflect.Type {
+	return o.source.Type()
+}
+
+func (o *ObservableFromPublisher) subscribeActual(ctx context.Context, ob Observer) {
+	subscribeFlowable(ctx, o.source, &observerSubscriber{actual: ob})
+}
+
+var _ Subscriber = (*observerSubscriber)(nil)
+
+// observerSubscriber relays the signals of a Publisher to an Observer, with an unbounded demand
+type observerSubscriber struct {
+	actual Observer
+}
+
+func (s *observerSubscriber) Type() reflect.Type {
+	return s.actual.Type()
+}
+
+func (s *observerSubscriber) OnSubscribe(subscription Subscription) {
+	s.actual.OnSubscribe(&subscriptionDisposable{subscription: subscription})
+	subscription.Request(math.MaxInt64)
+}
+
+func (s *observerSubscriber) OnNext(ctx context.Context, msg interface{}) {
+	s.actual.OnNext(ctx, msg)
+}
+
+func (s *observerSubscriber) OnError(ctx context.Context, err error) {
+	s.actual.OnError(ctx, err)
+}
+
+func (s *observerSubscriber) OnComplete(ctx context.Context) {
+	s.actual.OnComplete(ctx)
+}
+
+var _ Disposable = (*subscriptionDisposable)(nil)
+
+// subscriptionDisposable cancels a Subscription on disposal
+type subscriptionDisposable struct {
+	subscription Subscription
+	disposed     int32
+}
+
+func (d *subscriptionDisposable) Dispose() {
+	if atomic.CompareAndSwapInt32(&d.disposed, 0, 1) {
+		d.subscription.Cancel()
+	}
+}
+
+func (d *subscriptionDisposable) IsDisposed() bool {
+	return atomic.LoadInt32(&d.disposed) == 1
+}
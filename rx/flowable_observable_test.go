@@ -0,0 +1,118 @@
+package rx
+
+import (
+	"context"
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBaseObservable_ToFlowable(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("ToFlowable_should_KeepTheTypeOfTheSource", func(t *testing.T) {
+		assert.Equal(t, intType, Just(1).ToFlowable(BackpressureBuffer).Type())
+	})
+
+	t.Run("BackpressureBuffer_should_BufferTheItemsUntilRequested", func(t *testing.T) {
+		s := newTCKSubscriber(2)
+		Just(1, 2, 3, 4, 5).ToFlowable(BackpressureBuffer).Subscribe(ctx, s)
+		assert.Equal(t, []interface{}{1, 2}, s.Items())
+		assert.False(t, s.Completed())
+		s.Subscription().Request(3)
+		assert.Equal(t, []interface{}{1, 2, 3, 4, 5}, s.Items())
+		assert.True(t, s.Completed())
+	})
+
+	t.Run("BackpressureDrop_should_DropTheItemsNotRequested", func(t *testing.T) {
+		s := newTCKSubscriber(2)
+		Just(1, 2, 3, 4, 5).ToFlowable(BackpressureDrop).Subscribe(ctx, s)
+		assert.Equal(t, []interface{}{1, 2}, s.Items())
+		assert.True(t, s.Completed())
+	})
+
+	t.Run("BackpressureLatest_should_KeepTheLatestItemNotRequested", func(t *testing.T) {
+		s := newTCKSubscriber(1)
+		Just(1, 2, 3, 4, 5).ToFlowable(BackpressureLatest).Subscribe(ctx, s)
+		assert.Equal(t, []interface{}{1}, s.Items())
+		assert.False(t, s.Completed(), "should complete once the latest item is emitted")
+		s.Subscription().Request(1)
+		assert.Equal(t, []interface{}{1, 5}, s.Items())
+		assert.True(t, s.Completed())
+	})
+
+	t.Run("BackpressureError_should_SignalAMissingBackpressureError", func(t *testing.T) {
+		disposed := false
+		s := newTCKSubscriber(2)
+		Create(func(ctx context.Context, emitter ObservableEmitter) {
+			emitter.SetDisposable(Disposables.FromFunc(func() {
+				disposed = true
+			}))
+			for i := 1; i <= 5 && !emitter.IsDisposed(); i++ {
+				emitter.OnNext(ctx, i)
+			}
+			emitter.OnComplete(ctx)
+		}).ToFlowable(BackpressureError).Subscribe(ctx, s)
+		assert.Equal(t, []interface{}{1, 2}, s.Items())
+		var missing *MissingBackpressureError
+		if assert.True(t, errors.As(s.Err(), &missing)) {
+			assert.Equal(t, 0, missing.Capacity)
+		}
+		assert.True(t, errors.Is(s.Err(), ErrMissingBackpressure))
+		assert.True(t, disposed, "should dispose the source")
+	})
+}
+
+func TestBaseFlowable_ToObservable(t *testing.T) {
+	t.Run("ToObservable_should_RequestEveryItem", func(t *testing.T) {
+		var items []int
+		err := Range(0, 5).ToFlowable(BackpressureError).ToObservable().BlockingToSlice(context.Background(), &items)
+		assert.NoError(t, err)
+		assert.Equal(t, []int{0, 1, 2, 3, 4}, items)
+	})
+
+	t.Run("ToObservable_should_SignalTheErrorOfTheFlowable", func(t *testing.T) {
+		err := errorObservable(emptyInterfaceType, errTest).ToFlowable(BackpressureBuffer).ToObservable().
+			BlockingForEach(context.Background(), func(interface{}) {})
+		assert.Equal(t, errTest, err)
+	})
+
+	t.Run("ToObservable_should_CancelTheSubscriptionOnDisposal", func(t *testing.T) {
+		ob := newTestObserver()
+		asyncRange(math.MaxInt64).ToFlowable(BackpressureBuffer).ToObservable().Subscribe(context.Background(), ob)
+		ob.Dispose()
+		time.Sleep(quiet)
+		received := len(ob.Items())
+		time.Sleep(quiet)
+		assert.Equal(t, received, len(ob.Items()))
+		assert.False(t, ob.Completed())
+	})
+}
+
+// bareFlowable embeds BaseFlowable without implementing Subscribe
+type bareFlowable struct {
+	BaseFlowable
+}
+
+func (f *bareFlowable) Type() reflect.Type {
+	return emptyInterfaceType
+}
+
+func TestBaseFlowable_Subscribe(t *testing.T) {
+	t.Run("Subscribe_should_FailIfTheEmbeddingTypeDoesNotImplementIt", func(t *testing.T) {
+		source := &bareFlowable{}
+		source.Self = func() Publisher {
+			return source
+		}
+		s := newTCKSubscriber(1)
+		assert.NotPanics(t, func() {
+			source.Subscribe(context.Background(), s)
+		})
+		assert.Equal(t, []string{"subscribe", "error"}, s.Signals())
+		assert.EqualError(t, s.Err(), "*rx.bareFlowable embeds BaseFlowable without implementing Subscribe")
+	})
+}
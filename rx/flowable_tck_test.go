@@ -0,0 +1,369 @@
+package rx
+
+import (
+	"context"
+	"math"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// tckSubscriber records the signals it receives, checking the rules of Reactive Streams a Subscriber can observe.
+// It requests initialRequest items on subscription unless it's 0, and calls onNext, if any, on every item
+type tckSubscriber struct {
+	initialRequest int64
+	onNext         func(s *tckSubscriber, msg interface{})
+
+	mu           sync.Mutex
+	subscription Subscription
+	signals      []string
+	items        []interface{}
+	err          error
+	completed    bool
+	done         chan struct{}
+
+	calling    int32
+	concurrent int32
+	depth      int32
+	maxDepth   int32
+}
+
+func newTCKSubscriber(initialRequest int64) *tckSubscriber {
+	return &tckSubscriber{initialRequest: initialRequest, done: make(chan struct{})}
+}
+
+func (s *tckSubscriber) Type() reflect.Type {
+	return emptyInterfaceType
+}
+
+func (s *tckSubscriber) enter(signal string) {
+	if !atomic.CompareAndSwapInt32(&s.calling, 0, 1) {
+		atomic.StoreInt32(&s.concurrent, 1)
+	}
+	s.mu.Lock()
+	s.signals = append(s.signals, signal)
+	s.mu.Unlock()
+}
+
+func (s *tckSubscriber) exit() {
+	atomic.StoreInt32(&s.calling, 0)
+}
+
+func (s *tckSubscriber) OnSubscribe(subscription Subscription) {
+	s.enter("subscribe")
+	s.mu.Lock()
+	s.subscription = subscription
+	s.mu.Unlock()
+	s.exit()
+	if s.initialRequest != 0 {
+		subscription.Request(s.initialRequest)
+	}
+}
+
+func (s *tckSubscriber) OnNext(ctx context.Context, msg interface{}) {
+	s.enter("next")
+	s.mu.Lock()
+	s.items = append(s.items, msg)
+	s.mu.Unlock()
+	s.exit()
+	if s.onNext != nil {
+		depth := atomic.AddInt32(&s.depth, 1)
+		if depth > atomic.LoadInt32(&s.maxDepth) {
+			atomic.StoreInt32(&s.maxDepth, depth)
+		}
+		s.onNext(s, msg)
+		atomic.AddInt32(&s.depth, -1)
+	}
+}
+
+func (s *tckSubscriber) OnError(ctx context.Context, err error) {
+	s.enter("error")
+	defer s.exit()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.err = err
+	close(s.done)
+}
+
+func (s *tckSubscriber) OnComplete(ctx context.Context) {
+	s.enter("complete")
+	defer s.exit()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.completed = true
+	close(s.done)
+}
+
+func (s *tckSubscriber) Subscription() Subscription {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.subscription
+}
+
+func (s *tckSubscriber) Signals() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.signals...)
+}
+
+func (s *tckSubscriber) Items() []interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]interface{}(nil), s.items...)
+}
+
+func (s *tckSubscriber) Err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.err
+}
+
+func (s *tckSubscriber) Completed() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.completed
+}
+
+func (s *tckSubscriber) awaitItems(t *testing.T, n int) bool {
+	return assert.Eventually(t, func() bool {
+		return len(s.Items()) >= n
+	}, time.Second, time.Millisecond, "should receive %d items", n)
+}
+
+func (s *tckSubscriber) awaitTermination(t *testing.T) bool {
+	select {
+	case <-s.done:
+		return true
+	case <-time.After(time.Second):
+		return assert.Fail(t, "should terminate")
+	}
+}
+
+// publisherVerification checks a Publisher against the rules §1 to §3 of Reactive Streams, in the spirit of the
+// TCK's PublisherVerification. createPublisher creates a Publisher of n items, or of unbounded items if n is
+// math.MaxInt64. If lossless is false, the Publisher may drop the items emitted beyond the demand, and the rules
+// expecting every item to be emitted are skipped
+type publisherVerification struct {
+	createPublisher       func(n int64) Publisher
+	createFailedPublisher func() Publisher
+	lossless              bool
+}
+
+// quiet is how long the verification waits to check that nothing more is signaled
+const quiet = 20 * time.Millisecond
+
+func (v publisherVerification) run(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Rule109_should_SignalOnSubscribeFirst", func(t *testing.T) {
+		s := newTCKSubscriber(1)
+		v.createPublisher(3).Subscribe(ctx, s)
+		s.awaitItems(t, 1)
+		assert.Equal(t, "subscribe", s.Signals()[0])
+	})
+
+	t.Run("Rule101_should_NotEmitMoreThanRequested", func(t *testing.T) {
+		s := newTCKSubscriber(3)
+		v.createPublisher(10).Subscribe(ctx, s)
+		if !s.awaitItems(t, 3) {
+			return
+		}
+		time.Sleep(quiet)
+		assert.Len(t, s.Items(), 3)
+		if !v.lossless {
+			return
+		}
+		assert.False(t, s.Completed(), "should not complete before emitting every item")
+		s.Subscription().Request(7)
+		if s.awaitTermination(t) {
+			assert.Equal(t, []interface{}{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, s.Items())
+			assert.True(t, s.Completed())
+		}
+	})
+
+	t.Run("Rule103_should_SignalSerially", func(t *testing.T) {
+		const n = 500
+		s := newTCKSubscriber(1)
+		s.onNext = func(s *tckSubscriber, msg interface{}) {
+			go s.Subscription().Request(1)
+		}
+		v.createPublisher(n).Subscribe(ctx, s)
+		if v.lossless {
+			s.awaitTermination(t)
+			assert.Len(t, s.Items(), n)
+		} else {
+			s.awaitItems(t, 1)
+			time.Sleep(quiet)
+			assert.LessOrEqual(t, len(s.Items()), n)
+		}
+		assert.Equal(t, int32(0), atomic.LoadInt32(&s.concurrent), "should never signal concurrently")
+	})
+
+	t.Run("Rule105_should_CompleteAfterTheLastItem", func(t *testing.T) {
+		s := newTCKSubscriber(math.MaxInt64)
+		v.createPublisher(5).Subscribe(ctx, s)
+		if !s.awaitTermination(t) {
+			return
+		}
+		assert.True(t, s.Completed())
+		signals := s.Signals()
+		assert.Equal(t, "complete", signals[len(signals)-1])
+		if v.lossless {
+			assert.Equal(t, []interface{}{0, 1, 2, 3, 4}, s.Items())
+		}
+	})
+
+	t.Run("Rule104_should_SignalTheErrorOfAFailedPublisher", func(t *testing.T) {
+		s := newTCKSubscriber(1)
+		v.createFailedPublisher().Subscribe(ctx, s)
+		if s.awaitTermination(t) {
+			assert.Equal(t, errTest, s.Err())
+			assert.Equal(t, []string{"subscribe", "error"}, s.Signals())
+		}
+	})
+
+	t.Run("Rule303_should_BoundTheRecursionOfRequestFromOnNext", func(t *testing.T) {
+		const n = 10000
+		s := newTCKSubscriber(1)
+		s.onNext = func(s *tckSubscriber, msg interface{}) {
+			s.Subscription().Request(1)
+		}
+		v.createPublisher(n).Subscribe(ctx, s)
+		if !s.awaitTermination(t) {
+			return
+		}
+		assert.Equal(t, int32(1), atomic.LoadInt32(&s.maxDepth), "should not call OnNext from Request")
+		if v.lossless {
+			assert.Len(t, s.Items(), n)
+		}
+	})
+
+	t.Run("Rule306_should_IgnoreRequestAfterCancel", func(t *testing.T) {
+		s := newTCKSubscriber(2)
+		v.createPublisher(math.MaxInt64).Subscribe(ctx, s)
+		if !s.awaitItems(t, 2) {
+			return
+		}
+		s.Subscription().Cancel()
+		s.Subscription().Request(10)
+		time.Sleep(quiet)
+		assert.Len(t, s.Items(), 2)
+		assert.False(t, s.Completed())
+	})
+
+	t.Run("Rule307_should_IgnoreCancelAfterCancel", func(t *testing.T) {
+		s := newTCKSubscriber(0)
+		v.createPublisher(10).Subscribe(ctx, s)
+		s.Subscription().Cancel()
+		s.Subscription().Cancel()
+		time.Sleep(quiet)
+		assert.Equal(t, []string{"subscribe"}, s.Signals())
+	})
+
+	t.Run("Rule308_should_StopEmittingOnceCancelled", func(t *testing.T) {
+		s := newTCKSubscriber(math.MaxInt64)
+		v.createPublisher(math.MaxInt64).Subscribe(ctx, s)
+		if !s.awaitItems(t, 10) {
+			return
+		}
+		s.Subscription().Cancel()
+		// an item being emitted while cancelling may still be delivered
+		time.Sleep(quiet)
+		received := len(s.Items())
+		time.Sleep(quiet)
+		assert.Equal(t, received, len(s.Items()))
+	})
+
+	t.Run("Rule309_should_SignalAnErrorOnNonPositiveRequest", func(t *testing.T) {
+		for _, n := range []int64{0, -1} {
+			s := newTCKSubscriber(0)
+			v.createPublisher(math.MaxInt64).Subscribe(ctx, s)
+			s.Subscription().Request(n)
+			if s.awaitTermination(t) {
+				assert.Equal(t, ErrNonPositiveRequest, s.Err())
+				assert.Empty(t, s.Items())
+			}
+		}
+	})
+
+	t.Run("Rule317_should_SupportADemandUpToMaxInt64", func(t *testing.T) {
+		s := newTCKSubscriber(math.MaxInt64 - 1)
+		s.onNext = func(s *tckSubscriber, msg interface{}) {
+			if msg == 0 {
+				s.Subscription().Request(math.MaxInt64)
+			}
+		}
+		v.createPublisher(5).Subscribe(ctx, s)
+		if !s.awaitTermination(t) {
+			return
+		}
+		assert.True(t, s.Completed())
+		if v.lossless {
+			assert.Len(t, s.Items(), 5)
+		}
+	})
+}
+
+func TestFlowable_ReactiveStreams(t *testing.T) {
+	failed := func(strategy BackpressureStrategy) func() Publisher {
+		return func() Publisher {
+			return errorObservable(emptyInterfaceType, errTest).ToFlowable(strategy)
+		}
+	}
+	t.Run("SynchronousBuffer", publisherVerification{
+		createPublisher: func(n int64) Publisher {
+			if n == math.MaxInt64 {
+				return asyncRange(int(n)).ToFlowable(BackpressureBuffer)
+			}
+			return Range(0, int(n)).ToFlowable(BackpressureBuffer)
+		},
+		createFailedPublisher: failed(BackpressureBuffer),
+		lossless:              true,
+	}.run)
+	for _, tt := range []struct {
+		name     string
+		strategy BackpressureStrategy
+		lossless bool
+	}{
+		{name: "AsynchronousBuffer", strategy: BackpressureBuffer, lossless: true},
+		{name: "AsynchronousDrop", strategy: BackpressureDrop},
+		{name: "AsynchronousLatest", strategy: BackpressureLatest},
+	} {
+		t.Run(tt.name, publisherVerification{
+			createPublisher: func(n int64) Publisher {
+				return asyncRange(int(n)).ToFlowable(tt.strategy)
+			},
+			createFailedPublisher: failed(tt.strategy),
+			lossless:              tt.lossless,
+		}.run)
+	}
+	for _, tt := range []struct {
+		name     string
+		operator func(f Flowable) Flowable
+		lossless bool
+	}{
+		{name: "OnBackpressureBuffer", operator: func(f Flowable) Flowable {
+			return f.OnBackpressureBuffer(0, nil, BackpressureOverflowError)
+		}, lossless: true},
+		{name: "OnBackpressureDrop", operator: func(f Flowable) Flowable {
+			return f.OnBackpressureDrop(nil)
+		}},
+		{name: "OnBackpressureLatest", operator: func(f Flowable) Flowable {
+			return f.OnBackpressureLatest()
+		}},
+	} {
+		t.Run(tt.name, publisherVerification{
+			createPublisher: func(n int64) Publisher {
+				return tt.operator(asyncRange(int(n)).ToFlowable(BackpressureMissing))
+			},
+			createFailedPublisher: func() Publisher {
+				return tt.operator(errorObservable(emptyInterfaceType, errTest).ToFlowable(BackpressureMissing))
+			},
+			lossless: tt.lossless,
+		}.run)
+	}
+}
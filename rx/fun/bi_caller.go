@@ -0,0 +1,65 @@
+package fun
+
+import (
+	"context"
+	"reflect"
+)
+
+type BiCaller interface {
+	FirstReceiveType() reflect.Type
+	SecondReceiveType() reflect.Type
+	ReturnType() reflect.Type
+
+	Call(ctx context.Context, first, second interface{}) (interface{}, error)
+}
+
+var _ BiCaller = (*biCallerImpl)(nil)
+
+type biCallerImpl struct {
+	callerImpl
+	secondReceiveType reflect.Type
+}
+
+func (s *biCallerImpl) FirstReceiveType() reflect.Type {
+	return s.receiveType
+}
+
+func (s *biCallerImpl) SecondReceiveType() reflect.Type {
+	return s.secondReceiveType
+}
+
+func (s *biCallerImpl) Call(ctx context.Context, first, second interface{}) (interface{}, error) {
+	args := make([]reflect.Value, 0, 3)
+	if s.hasContext {
+		args = append(args, reflect.ValueOf(ctx))
+	}
+	args = append(args, valueOf(first, s.receiveType), valueOf(second, s.secondReceiveType))
+	return s.convertOutput(s.f.Call(args))
+}
+
+func BiCallerOf(call interface{}) (BiCaller, error) {
+	callValue, err := funcValueOf(call)
+	if err != nil {
+		return nil, err
+	}
+	receiveTypes, hasContext, err := receiveTypesOf(callValue.Type(), 2)
+	if err != nil {
+		return nil, err
+	}
+	returnType, hasError, err := returnTypeOf(callValue.Type())
+	if err != nil {
+		return nil, err
+	}
+	return &biCallerImpl{
+		callerImpl: callerImpl{
+			runnable: runnable{
+				f:           callValue,
+				receiveType: receiveTypes[0],
+				hasContext:  hasContext,
+			},
+			returnType: returnType,
+			hasError:   hasError,
+		},
+		secondReceiveType: receiveTypes[1],
+	}, nil
+}
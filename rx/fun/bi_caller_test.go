@@ -0,0 +1,131 @@
+package fun
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBiCallerOf(t *testing.T) {
+	t.Run("BiCallerOf_should_ReturnTheShapeOfGivenFunction", func(t *testing.T) {
+		intType := reflect.TypeOf((*int)(nil)).Elem()
+		stringType := reflect.TypeOf((*string)(nil)).Elem()
+		boolType := reflect.TypeOf((*bool)(nil)).Elem()
+		tests := []struct {
+			name       string
+			f          interface{}
+			err        error
+			firstType  reflect.Type
+			secondType reflect.Type
+			outType    reflect.Type
+		}{
+			{
+				name:       "SimpleFunction",
+				f:          func(a int, b string) bool { return true },
+				firstType:  intType,
+				secondType: stringType,
+				outType:    boolType,
+			},
+			{
+				name:       "FunctionWithContextAndError",
+				f:          func(ctx context.Context, a int, b string) (bool, error) { return true, nil },
+				firstType:  intType,
+				secondType: stringType,
+				outType:    boolType,
+			},
+			{
+				name: "TooFewArguments",
+				f:    func(a int) bool { return true },
+				err:  fmt.Errorf("call should have either 2 or 3 arguments but got %d", 1),
+			},
+			{
+				name: "InvalidFirstArgument",
+				f:    func(a, b, c int) bool { return true },
+				err:  fmt.Errorf("the first argument should be context.Context but got int"),
+			},
+			{
+				name: "EmptyReturnValue",
+				f:    func(a, b int) {},
+				err:  fmt.Errorf("call should return either 1 or 2 values but got 0"),
+			},
+			{
+				name: "InvalidSecondReturnValue",
+				f:    func(a, b int) (bool, int) { return true, 0 },
+				err:  fmt.Errorf("the second return value can only be error but got int"),
+			},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				sp, err := BiCallerOf(tt.f)
+				if tt.err != nil {
+					assert.EqualError(t, err, tt.err.Error())
+					return
+				} else if !assert.NoError(t, err) {
+					return
+				}
+				assert.Equal(t, tt.firstType, sp.FirstReceiveType(), "expect first receive type %s", tt.firstType)
+				assert.Equal(t, tt.secondType, sp.SecondReceiveType(), "expect second receive type %s", tt.secondType)
+				assert.Equal(t, tt.outType, sp.ReturnType(), "expect return type %s", tt.outType)
+			})
+		}
+	})
+}
+
+func TestBiCallerImpl_Call(t *testing.T) {
+	t.Run("Call_should_CallAsExpected", func(t *testing.T) {
+		tests := []struct {
+			name      string
+			f         interface{}
+			first     interface{}
+			second    interface{}
+			expect    interface{}
+			expectErr error
+		}{
+			{
+				name:   "SimpleCall",
+				f:      func(a, b int) int { return a + b },
+				first:  1,
+				second: 2,
+				expect: 3,
+			},
+			{
+				name:      "CallWithError",
+				f:         func(a, b int) (int, error) { return 0, errTest },
+				first:     1,
+				second:    2,
+				expectErr: errTest,
+			},
+			{
+				name:   "CallWithContext",
+				f:      func(ctx context.Context, a, b int) int { return a - b },
+				first:  3,
+				second: 1,
+				expect: 2,
+			},
+			{
+				name:   "CallWithNil",
+				f:      func(a, b error) bool { return a == b },
+				expect: true,
+			},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				s, err := BiCallerOf(tt.f)
+				if !assert.NoError(t, err, "should call BiCallerOf without error") {
+					return
+				}
+				value, err := s.Call(context.Background(), tt.first, tt.second)
+				if tt.expectErr != nil {
+					assert.EqualError(t, err, tt.expectErr.Error())
+					return
+				} else if !assert.NoError(t, err, "should Call without error") {
+					return
+				}
+				assert.Equal(t, tt.expect, value)
+			})
+		}
+	})
+}
@@ -2,7 +2,6 @@ package fun
 
 import (
 	"context"
-	"fmt"
 	"reflect"
 )
 
@@ -56,51 +55,22 @@ func (s *callerImpl) convertOutput(values []reflect.Value) (interface{}, error)
 }
 
 func CallerOf(call interface{}) (Caller, error) {
-	if call == nil {
-		return nil, fmt.Errorf("call cannot be nil")
+	callValue, err := funcValueOf(call)
+	if err != nil {
+		return nil, err
 	}
-	callValue := reflect.ValueOf(call)
-	if callValue.Type().Kind() != reflect.Func {
-		return nil, fmt.Errorf("call should bye a function")
+	receiveTypes, hasContext, err := receiveTypesOf(callValue.Type(), 1)
+	if err != nil {
+		return nil, err
 	}
-	callType := callValue.Type()
-	hasContext := false
-	var receiveType reflect.Type
-	numIn := callType.NumIn()
-	switch numIn {
-	default:
-		return nil, fmt.Errorf("call should have either 1 or 2 arguments but got %d", numIn)
-	case 1:
-		receiveType = callType.In(0)
-	case 2:
-		hasContext = true
-		firstArgType := callType.In(0)
-		if firstArgType != contextType {
-			return nil, fmt.Errorf("the first argument should be context.Context but got %s", firstArgType)
-		}
-		receiveType = callType.In(1)
-	}
-
-	hasError := false
-	var returnType reflect.Type
-	numOut := callType.NumOut()
-	switch numOut {
-	default:
-		return nil, fmt.Errorf("call should return either 1 or 2 values but got %d", numOut)
-	case 1:
-		returnType = callType.Out(0)
-	case 2:
-		hasError = true
-		returnType = callType.Out(0)
-		secondArgType := callType.Out(1)
-		if secondArgType != errorType {
-			return nil, fmt.Errorf("the second return value can only be error but got %s", secondArgType)
-		}
+	returnType, hasError, err := returnTypeOf(callValue.Type())
+	if err != nil {
+		return nil, err
 	}
 	return &callerImpl{
 		runnable: runnable{
 			f:           callValue,
-			receiveType: receiveType,
+			receiveType: receiveTypes[0],
 			hasContext:  hasContext,
 		},
 		returnType: returnType,
@@ -52,6 +52,11 @@ func TestCallerOf(t *testing.T) {
 				inType:  reflect.TypeOf((*int)(nil)).Elem(),
 				outType: reflect.TypeOf((*int)(nil)).Elem(),
 			},
+			{
+				name: "NotAFunction",
+				f:    1,
+				err:  fmt.Errorf("call should be a function"),
+			},
 			{
 				name: "EmptyArgument",
 				f: func() int {
@@ -142,6 +147,12 @@ func TestCallerImpl_Call(t *testing.T) {
 				in:     3,
 				expect: 3,
 			},
+			{
+				name:   "CallWithNil",
+				f:      func(err error) bool { return err == nil },
+				in:     nil,
+				expect: true,
+			},
 		}
 		for _, tt := range tests {
 			t.Run(tt.name, func(t *testing.T) {
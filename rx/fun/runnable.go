@@ -15,11 +15,19 @@ func (r *runnable) prepareArguments(ctx context.Context, in interface{}) []refle
 	if r.hasContext {
 		return []reflect.Value{
 			reflect.ValueOf(ctx),
-			reflect.ValueOf(in),
+			valueOf(in, r.receiveType),
 		}
 	} else {
 		return []reflect.Value{
-			reflect.ValueOf(in),
+			valueOf(in, r.receiveType),
 		}
 	}
 }
+
+// valueOf converts a nil input to the zero value of the expected type, so it can be used as an argument
+func valueOf(in interface{}, t reflect.Type) reflect.Value {
+	if in == nil {
+		return reflect.Zero(t)
+	}
+	return reflect.ValueOf(in)
+}
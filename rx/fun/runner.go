@@ -42,29 +42,14 @@ func (r *runnerImpl) convertOutput(values []reflect.Value) error {
 	}
 }
 func RunnerOf(run interface{}) (Runner, error) {
-	if run == nil {
-		return nil, fmt.Errorf("call cannot be nil")
-	}
-	runValue := reflect.ValueOf(run)
-	if runValue.Type().Kind() != reflect.Func {
-		return nil, fmt.Errorf("call should bye a function")
+	runValue, err := funcValueOf(run)
+	if err != nil {
+		return nil, err
 	}
 	runType := runValue.Type()
-	hasContext := false
-	var receiveType reflect.Type
-	numIn := runType.NumIn()
-	switch numIn {
-	default:
-		return nil, fmt.Errorf("call should have either 1 or 2 arguments but got %d", numIn)
-	case 1:
-		receiveType = runType.In(0)
-	case 2:
-		hasContext = true
-		firstArgType := runType.In(0)
-		if firstArgType != contextType {
-			return nil, fmt.Errorf("the first argument should be context.Context but got %s", firstArgType)
-		}
-		receiveType = runType.In(1)
+	receiveTypes, hasContext, err := receiveTypesOf(runType, 1)
+	if err != nil {
+		return nil, err
 	}
 
 	hasError := false
@@ -83,7 +68,7 @@ func RunnerOf(run interface{}) (Runner, error) {
 	return &runnerImpl{
 		runnable: runnable{
 			f:           runValue,
-			receiveType: receiveType,
+			receiveType: receiveTypes[0],
 			hasContext:  hasContext,
 		},
 		hasError: hasError,
@@ -0,0 +1,51 @@
+package fun
+
+import (
+	"context"
+	"reflect"
+)
+
+type Supplier interface {
+	ReturnType() reflect.Type
+
+	Call(ctx context.Context) (interface{}, error)
+}
+
+var _ Supplier = (*supplierImpl)(nil)
+
+type supplierImpl struct {
+	callerImpl
+}
+
+func (s *supplierImpl) Call(ctx context.Context) (interface{}, error) {
+	var args []reflect.Value
+	if s.hasContext {
+		args = []reflect.Value{reflect.ValueOf(ctx)}
+	}
+	return s.convertOutput(s.f.Call(args))
+}
+
+func SupplierOf(call interface{}) (Supplier, error) {
+	callValue, err := funcValueOf(call)
+	if err != nil {
+		return nil, err
+	}
+	_, hasContext, err := receiveTypesOf(callValue.Type(), 0)
+	if err != nil {
+		return nil, err
+	}
+	returnType, hasError, err := returnTypeOf(callValue.Type())
+	if err != nil {
+		return nil, err
+	}
+	return &supplierImpl{
+		callerImpl: callerImpl{
+			runnable: runnable{
+				f:          callValue,
+				hasContext: hasContext,
+			},
+			returnType: returnType,
+			hasError:   hasError,
+		},
+	}, nil
+}
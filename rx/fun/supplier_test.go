@@ -0,0 +1,108 @@
+package fun
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSupplierOf(t *testing.T) {
+	t.Run("SupplierOf_should_ReturnTheShapeOfGivenFunction", func(t *testing.T) {
+		intType := reflect.TypeOf((*int)(nil)).Elem()
+		tests := []struct {
+			name    string
+			f       interface{}
+			err     error
+			outType reflect.Type
+		}{
+			{
+				name:    "SimpleFunction",
+				f:       func() int { return 1 },
+				outType: intType,
+			},
+			{
+				name:    "FunctionWithContextAndError",
+				f:       func(ctx context.Context) (int, error) { return 1, nil },
+				outType: intType,
+			},
+			{
+				name: "TooManyArguments",
+				f:    func(a, b int) int { return 1 },
+				err:  fmt.Errorf("call should have either 0 or 1 arguments but got 2"),
+			},
+			{
+				name: "InvalidFirstArgument",
+				f:    func(a int) int { return 1 },
+				err:  fmt.Errorf("the first argument should be context.Context but got int"),
+			},
+			{
+				name: "EmptyReturnValue",
+				f:    func() {},
+				err:  fmt.Errorf("call should return either 1 or 2 values but got 0"),
+			},
+			{
+				name: "InvalidSecondReturnValue",
+				f:    func() (int, int) { return 0, 0 },
+				err:  fmt.Errorf("the second return value can only be error but got int"),
+			},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				sp, err := SupplierOf(tt.f)
+				if tt.err != nil {
+					assert.EqualError(t, err, tt.err.Error())
+					return
+				} else if !assert.NoError(t, err) {
+					return
+				}
+				assert.Equal(t, tt.outType, sp.ReturnType(), "expect return type %s", tt.outType)
+			})
+		}
+	})
+}
+
+func TestSupplierImpl_Call(t *testing.T) {
+	t.Run("Call_should_CallAsExpected", func(t *testing.T) {
+		tests := []struct {
+			name      string
+			f         interface{}
+			expect    interface{}
+			expectErr error
+		}{
+			{
+				name:   "SimpleCall",
+				f:      func() int { return 1 },
+				expect: 1,
+			},
+			{
+				name:      "CallWithError",
+				f:         func() (int, error) { return 0, errTest },
+				expectErr: errTest,
+			},
+			{
+				name:   "CallWithContext",
+				f:      func(ctx context.Context) bool { return ctx != nil },
+				expect: true,
+			},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				s, err := SupplierOf(tt.f)
+				if !assert.NoError(t, err, "should call SupplierOf without error") {
+					return
+				}
+				value, err := s.Call(context.Background())
+				if tt.expectErr != nil {
+					assert.EqualError(t, err, tt.expectErr.Error())
+					return
+				} else if !assert.NoError(t, err, "should Call without error") {
+					return
+				}
+				assert.Equal(t, tt.expect, value)
+			})
+		}
+	})
+}
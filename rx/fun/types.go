@@ -2,6 +2,7 @@ package fun
 
 import (
 	"context"
+	"fmt"
 	"reflect"
 )
 
@@ -9,3 +10,51 @@ var (
 	contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
 	errorType   = reflect.TypeOf((*error)(nil)).Elem()
 )
+
+// funcValueOf returns the reflect.Value of call, checking that it is a function
+func funcValueOf(call interface{}) (reflect.Value, error) {
+	if call == nil {
+		return reflect.Value{}, fmt.Errorf("call cannot be nil")
+	}
+	callValue := reflect.ValueOf(call)
+	if callValue.Kind() != reflect.Func {
+		return reflect.Value{}, fmt.Errorf("call should be a function")
+	}
+	return callValue, nil
+}
+
+// receiveTypesOf returns the types of the n arguments of callType, which may be preceded by a context.Context
+func receiveTypesOf(callType reflect.Type, n int) (receiveTypes []reflect.Type, hasContext bool, err error) {
+	numIn := callType.NumIn()
+	switch numIn {
+	default:
+		return nil, false, fmt.Errorf("call should have either %d or %d arguments but got %d", n, n+1, numIn)
+	case n:
+	case n + 1:
+		hasContext = true
+		if firstArgType := callType.In(0); firstArgType != contextType {
+			return nil, false, fmt.Errorf("the first argument should be context.Context but got %s", firstArgType)
+		}
+	}
+	offset := numIn - n
+	for i := 0; i < n; i++ {
+		receiveTypes = append(receiveTypes, callType.In(offset+i))
+	}
+	return receiveTypes, hasContext, nil
+}
+
+// returnTypeOf returns the type of the value returned by callType, which may be followed by an error
+func returnTypeOf(callType reflect.Type) (returnType reflect.Type, hasError bool, err error) {
+	numOut := callType.NumOut()
+	switch numOut {
+	default:
+		return nil, false, fmt.Errorf("call should return either 1 or 2 values but got %d", numOut)
+	case 1:
+	case 2:
+		hasError = true
+		if secondArgType := callType.Out(1); secondArgType != errorType {
+			return nil, false, fmt.Errorf("the second return value can only be error but got %s", secondArgType)
+		}
+	}
+	return callType.Out(0), hasError, nil
+}
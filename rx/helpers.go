@@ -1,6 +1,11 @@
 package rx
 
-import "context"
+import (
+	"context"
+	"reflect"
+)
+
+var emptyInterfaceType = reflect.TypeOf((*interface{})(nil)).Elem()
 
 func isDone(ctx context.Context) bool {
 	select {
@@ -11,9 +16,35 @@ func isDone(ctx context.Context) bool {
 	}
 }
 
+// toError wraps a recovered panic value into a *PanicError, even if it's an error, to tell it from the errors
+// signaled normally
 func toError(e interface{}) error {
-	if err, ok := e.(error); ok {
-		return err
-	}
 	return ErrPanic(e)
 }
+
+// isComparable reports whether v can be compared with == or used as a map key without panicking. Unlike
+// reflect.Type.Comparable, it checks the dynamic values held by interfaces, such as those of an interface{} array
+func isComparable(v interface{}) bool {
+	return v == nil || reflect.ValueOf(v).Comparable()
+}
+
+// equalValues compares values of comparable types with ==, and the others with reflect.DeepEqual
+func equalValues(a, b interface{}) bool {
+	if isComparable(a) && isComparable(b) {
+		return a == b
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// commonTypeOf returns the dynamic type shared by all the items, or nil if there is none
+func commonTypeOf(items []interface{}) reflect.Type {
+	var typ reflect.Type
+	for i, item := range items {
+		t := reflect.TypeOf(item)
+		if t == nil || (i > 0 && t != typ) {
+			return nil
+		}
+		typ = t
+	}
+	return typ
+}
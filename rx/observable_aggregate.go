@@ -0,0 +1,412 @@
+package rx
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"www.github.com/secretworry/rx-go/rx/fun"
+)
+
+var (
+	intType     = reflect.TypeOf((*int)(nil)).Elem()
+	float64Type = reflect.TypeOf((*float64)(nil)).Elem()
+)
+
+// aggregator folds the items of one subscription into a single result
+type aggregator interface {
+	// next consumes an item, and reports whether the result is known without consuming any further item
+	next(ctx context.Context, msg interface{}) (bool, error)
+	// result returns the aggregated value, ok is false if there is nothing to emit
+	result() (value interface{}, ok bool, err error)
+}
+
+var _ Observable = (*ObservableAggregate)(nil)
+
+// ObservableAggregate emits at most one item aggregated from its source, disposing the source as soon as the
+// result is known
+type ObservableAggregate struct {
+	BaseObservable
+	source     ObservableSource
+	typ        reflect.Type
+	aggregator func() aggregator
+}
+
+func (o *ObservableAggregate) Init() *ObservableAggregate {
+	o.Self = func() ObservableSource {
+		return o
+	}
+	return o
+}
+
+func (o *ObservableAggregate) Type() reflect.Type {
+	return o.typ
+}
+
+func (o *ObservableAggregate) subscribeActual(ctx context.Context, ob Observer) {
+	subscribe(ctx, o.source, &aggregateObserver{
+		basicObserver: basicObserver{actual: ob},
+		aggregator:    o.aggregator(),
+	})
+}
+
+func newAggregate(source ObservableSource, typ reflect.Type, aggregator func() aggregator) Observable {
+	return onAssembly((&ObservableAggregate{
+		source:     source,
+		typ:        typ,
+		aggregator: aggregator,
+	}).Init())
+}
+
+var _ Observer = (*aggregateObserver)(nil)
+
+type aggregateObserver struct {
+	basicObserver
+	aggregator aggregator
+}
+
+func (o *aggregateObserver) Type() reflect.Type {
+	return emptyInterfaceType
+}
+
+func (o *aggregateObserver) OnNext(ctx context.Context, msg interface{}) {
+	if o.isTerminated() || isDone(ctx) {
+		return
+	}
+	done, err := o.aggregator.next(ctx, msg)
+	if err != nil {
+		o.fail(ctx, err)
+		return
+	}
+	if done {
+		o.Dispose()
+		o.OnComplete(ctx)
+	}
+}
+
+func (o *aggregateObserver) OnComplete(ctx context.Context) {
+	if o.terminate() {
+		value, ok, err := o.aggregator.result()
+		if err != nil {
+			o.actual.OnError(ctx, err)
+			return
+		}
+		if ok {
+			o.actual.OnNext(ctx, value)
+		}
+		o.actual.OnComplete(ctx)
+	}
+}
+
+func isIntKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return true
+	default:
+		return false
+	}
+}
+
+func isUintKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	default:
+		return false
+	}
+}
+
+func isNumericKind(kind reflect.Kind) bool {
+	return isIntKind(kind) || isUintKind(kind) || kind == reflect.Float32 || kind == reflect.Float64
+}
+
+// checkNumeric rejects the sources known to emit non-numeric items; items of sources typed as an interface are
+// checked when they arrive
+func checkNumeric(operator string, t reflect.Type) error {
+	if t.Kind() == reflect.Interface || isNumericKind(t.Kind()) {
+		return nil
+	}
+	return fmt.Errorf("%s expects a numeric source but got %s", operator, t)
+}
+
+func numericValueOf(msg interface{}) (reflect.Value, error) {
+	v := reflect.ValueOf(msg)
+	if !v.IsValid() {
+		return v, fmt.Errorf("expect a numeric item but got nil")
+	}
+	if !isNumericKind(v.Kind()) {
+		return v, fmt.Errorf("expect a numeric item but got %s", v.Type())
+	}
+	return v, nil
+}
+
+// compareNumeric returns a negative number if a < b, a positive number if a > b and 0 otherwise
+func compareNumeric(a, b reflect.Value) int {
+	switch {
+	case isIntKind(a.Kind()) && isIntKind(b.Kind()):
+		return compareOrdered(a.Int() < b.Int(), a.Int() > b.Int())
+	case isUintKind(a.Kind()) && isUintKind(b.Kind()):
+		return compareOrdered(a.Uint() < b.Uint(), a.Uint() > b.Uint())
+	default:
+		x, y := a.Convert(float64Type).Float(), b.Convert(float64Type).Float()
+		return compareOrdered(x < y, x > y)
+	}
+}
+
+func compareOrdered(less, greater bool) int {
+	switch {
+	case less:
+		return -1
+	case greater:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func predicateOf(predicate interface{}) (fun.Caller, error) {
+	caller, err := fun.CallerOf(predicate)
+	if err != nil {
+		return nil, err
+	}
+	if caller.ReturnType() != boolType {
+		return nil, fmt.Errorf("predicate should return bool but got %s", caller.ReturnType())
+	}
+	return caller, nil
+}
+
+// Count emits the number of items emitted by the source as an int
+func (b BaseObservable) Count() Observable {
+	return newAggregate(b.Self(), intType, func() aggregator {
+		return &countAggregator{}
+	})
+}
+
+type countAggregator struct {
+	count int
+}
+
+func (a *countAggregator) next(ctx context.Context, msg interface{}) (bool, error) {
+	a.count++
+	return false, nil
+}
+
+func (a *countAggregator) result() (interface{}, bool, error) {
+	return a.count, true, nil
+}
+
+// Sum emits the sum of the numeric items emitted by the source, in the type of the source.
+// The items of an interface{} source are summed in the type of the first one, or in float64 as soon as an item of
+// another type comes, so mixing ints and floats never truncates them
+func (b BaseObservable) Sum() Observable {
+	source := b.Self()
+	typ := source.Type()
+	if err := checkNumeric("Sum", typ); err != nil {
+		return errorObservable(typ, err)
+	}
+	return newAggregate(source, typ, func() aggregator {
+		return &sumAggregator{typ: typ}
+	})
+}
+
+type sumAggregator struct {
+	typ reflect.Type
+	sum reflect.Value
+}
+
+func (a *sumAggregator) next(ctx context.Context, msg interface{}) (bool, error) {
+	v, err := numericValueOf(msg)
+	if err != nil {
+		return false, err
+	}
+	if !a.sum.IsValid() {
+		typ := a.typ
+		if typ.Kind() == reflect.Interface {
+			typ = v.Type()
+		}
+		a.sum = reflect.New(typ).Elem()
+	} else if a.typ.Kind() == reflect.Interface && v.Type() != a.sum.Type() && a.sum.Type() != float64Type {
+		sum := reflect.New(float64Type).Elem()
+		sum.SetFloat(a.sum.Convert(float64Type).Float())
+		a.sum = sum
+	}
+	v = v.Convert(a.sum.Type())
+	switch {
+	case isIntKind(a.sum.Kind()):
+		a.sum.SetInt(a.sum.Int() + v.Int())
+	case isUintKind(a.sum.Kind()):
+		a.sum.SetUint(a.sum.Uint() + v.Uint())
+	default:
+		a.sum.SetFloat(a.sum.Float() + v.Float())
+	}
+	return false, nil
+}
+
+func (a *sumAggregator) result() (interface{}, bool, error) {
+	if !a.sum.IsValid() {
+		if a.typ.Kind() == reflect.Interface {
+			return 0, true, nil
+		}
+		return reflect.Zero(a.typ).Interface(), true, nil
+	}
+	return a.sum.Interface(), true, nil
+}
+
+// Min emits the smallest numeric item emitted by the source, or nothing if the source is empty
+func (b BaseObservable) Min() Observable {
+	return b.extremum("Min", -1)
+}
+
+// Max emits the largest numeric item emitted by the source, or nothing if the source is empty
+func (b BaseObservable) Max() Observable {
+	return b.extremum("Max", 1)
+}
+
+func (b BaseObservable) extremum(operator string, sign int) Observable {
+	source := b.Self()
+	typ := source.Type()
+	if err := checkNumeric(operator, typ); err != nil {
+		return errorObservable(typ, err)
+	}
+	return newAggregate(source, typ, func() aggregator {
+		return &extremumAggregator{sign: sign}
+	})
+}
+
+type extremumAggregator struct {
+	sign     int
+	extremum reflect.Value
+}
+
+func (a *extremumAggregator) next(ctx context.Context, msg interface{}) (bool, error) {
+	v, err := numericValueOf(msg)
+	if err != nil {
+		return false, err
+	}
+	if !a.extremum.IsValid() || compareNumeric(v, a.extremum)*a.sign > 0 {
+		a.extremum = v
+	}
+	return false, nil
+}
+
+func (a *extremumAggregator) result() (interface{}, bool, error) {
+	if !a.extremum.IsValid() {
+		return nil, false, nil
+	}
+	return a.extremum.Interface(), true, nil
+}
+
+// Average emits the mean of the numeric items emitted by the source as a float64, or nothing if the source is empty
+func (b BaseObservable) Average() Observable {
+	source := b.Self()
+	if err := checkNumeric("Average", source.Type()); err != nil {
+		return errorObservable(float64Type, err)
+	}
+	return newAggregate(source, float64Type, func() aggregator {
+		return &averageAggregator{}
+	})
+}
+
+type averageAggregator struct {
+	sum   float64
+	count int
+}
+
+func (a *averageAggregator) next(ctx context.Context, msg interface{}) (bool, error) {
+	v, err := numericValueOf(msg)
+	if err != nil {
+		return false, err
+	}
+	a.sum += v.Convert(float64Type).Float()
+	a.count++
+	return false, nil
+}
+
+func (a *averageAggregator) result() (interface{}, bool, error) {
+	if a.count == 0 {
+		return nil, false, nil
+	}
+	return a.sum / float64(a.count), true, nil
+}
+
+// All emits whether every item emitted by the source satisfies the predicate, it emits false on the first item
+// failing the predicate
+func (b BaseObservable) All(predicate interface{}) Observable {
+	return b.matches(predicate, false)
+}
+
+// Any emits whether any item emitted by the source satisfies the predicate, it emits true on the first item
+// satisfying the predicate
+func (b BaseObservable) Any(predicate interface{}) Observable {
+	return b.matches(predicate, true)
+}
+
+func (b BaseObservable) matches(predicate interface{}, stopOn bool) Observable {
+	caller, err := predicateOf(predicate)
+	if err != nil {
+		return errorObservable(boolType, err)
+	}
+	return newAggregate(b.Self(), boolType, func() aggregator {
+		return &matchAggregator{
+			match: func(ctx context.Context, msg interface{}) (bool, error) {
+				matched, err := caller.Call(ctx, msg)
+				if err != nil {
+					return false, err
+				}
+				return matched.(bool), nil
+			},
+			stopOn: stopOn,
+		}
+	})
+}
+
+// Contains emits whether the source emits an item equal to the given value. Values of comparable types are
+// compared with ==, and the others with reflect.DeepEqual
+func (b BaseObservable) Contains(value interface{}) Observable {
+	return newAggregate(b.Self(), boolType, func() aggregator {
+		return &matchAggregator{
+			match: func(ctx context.Context, msg interface{}) (bool, error) {
+				return equalValues(msg, value), nil
+			},
+			stopOn: true,
+		}
+	})
+}
+
+// IsEmpty emits whether the source completes without emitting any item
+func (b BaseObservable) IsEmpty() Observable {
+	return newAggregate(b.Self(), boolType, func() aggregator {
+		return &matchAggregator{
+			match: func(ctx context.Context, msg interface{}) (bool, error) {
+				return false, nil
+			},
+			stopOn: false,
+		}
+	})
+}
+
+// matchAggregator emits stopOn as soon as an item matches it, and !stopOn if the source completes before
+type matchAggregator struct {
+	match   func(ctx context.Context, msg interface{}) (bool, error)
+	stopOn  bool
+	stopped bool
+}
+
+func (a *matchAggregator) next(ctx context.Context, msg interface{}) (bool, error) {
+	matched, err := a.match(ctx, msg)
+	if err != nil {
+		return false, err
+	}
+	if matched == a.stopOn {
+		a.stopped = true
+		return true, nil
+	}
+	return false, nil
+}
+
+func (a *matchAggregator) result() (interface{}, bool, error) {
+	if a.stopped {
+		return a.stopOn, true, nil
+	}
+	return !a.stopOn, true, nil
+}
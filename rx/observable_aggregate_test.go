@@ -0,0 +1,111 @@
+package rx
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// blockingSingle subscribes to the observable, and returns the only item it emits, or nil if it emits nothing
+func blockingSingle(observable Observable) (interface{}, error) {
+	var ret interface{}
+	err := observable.BlockingForEach(context.Background(), func(v interface{}) {
+		ret = v
+	})
+	return ret, err
+}
+
+func TestBaseObservable_Aggregates(t *testing.T) {
+	tests := []struct {
+		name       string
+		observable Observable
+		expect     interface{}
+		expectErr  string
+	}{
+		{name: "Count", observable: Just(1, 2, 3).Count(), expect: 3},
+		{name: "CountEmpty", observable: Just().Count(), expect: 0},
+		{name: "Sum", observable: Just(1, 2, 3).Sum(), expect: 6},
+		{name: "SumFloats", observable: Just(1.5, 2.5).Sum(), expect: 4.0},
+		{name: "SumSameType", observable: Just(uint8(1), uint8(2)).Sum(), expect: uint8(3)},
+		{name: "SumMixed", observable: Just(uint8(1), 2).Sum(), expect: 3.0},
+		{name: "SumMixedIntsAndFloats", observable: Just(1, 2.5, 3).Sum(), expect: 6.5},
+		{name: "SumEmpty", observable: Just().Sum(), expect: 0},
+		{name: "SumOfTypedSource", observable: FromSlice([]int64{1, 2}).Sum(), expect: int64(3)},
+		{name: "SumNonNumeric", observable: Just(1, "a").Sum(), expectErr: "expect a numeric item but got string"},
+		{name: "Min", observable: Just(3, 1, 2).Min(), expect: 1},
+		{name: "MinEmpty", observable: Just().Min(), expect: nil},
+		{name: "Max", observable: Just(3, 1, 2.5).Max(), expect: 3},
+		{name: "Average", observable: Just(1, 2, 3, 4).Average(), expect: 2.5},
+		{name: "AverageEmpty", observable: Just().Average(), expect: nil},
+		{name: "AllTrue", observable: Just(2, 4).All(func(i int) bool { return i%2 == 0 }), expect: true},
+		{name: "AllFalse", observable: Just(2, 3).All(func(i int) bool { return i%2 == 0 }), expect: false},
+		{name: "AnyTrue", observable: Just(1, 2).Any(func(i int) bool { return i%2 == 0 }), expect: true},
+		{name: "AnyFalse", observable: Just(1, 3).Any(func(i int) bool { return i%2 == 0 }), expect: false},
+		{name: "AnyInvalidPredicate", observable: Just(1).Any(func(i int) int { return i }), expectErr: "predicate should return bool but got int"},
+		{name: "Contains", observable: Just(1, 2).Contains(2), expect: true},
+		{name: "ContainsDeeply", observable: Just([]int{1}).Contains([]int{1}), expect: true},
+		{name: "NotContains", observable: Just(1, 2).Contains(3), expect: false},
+		{name: "IsEmpty", observable: Just().IsEmpty(), expect: true},
+		{name: "IsNotEmpty", observable: Just(1).IsEmpty(), expect: false},
+		{name: "SequenceEqual", observable: Just(1, 2).SequenceEqual(Just(1, 2)), expect: true},
+		{name: "SequenceNotEqual", observable: Just(1, 2).SequenceEqual(Just(1, 3)), expect: false},
+		{name: "SequenceShorter", observable: Just(1).SequenceEqual(Just(1, 2)), expect: false},
+		{name: "SequenceLonger", observable: Just(1, 2).SequenceEqual(Just(1)), expect: false},
+		{name: "SequenceError", observable: Just(1).SequenceEqual(errorObservable(emptyInterfaceType, errTest)), expectErr: errTest.Error()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ret, err := blockingSingle(tt.observable)
+			if tt.expectErr != "" {
+				assert.EqualError(t, err, tt.expectErr)
+				return
+			} else if !assert.NoError(t, err, "should BlockingForEach without error") {
+				return
+			}
+			assert.Equal(t, tt.expect, ret)
+		})
+	}
+}
+
+func TestBaseObservable_AggregateShortCircuit(t *testing.T) {
+	t.Run("Aggregates_should_DisposeTheUpstreamOnceTheResultIsKnown", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			operator func(Observable) Observable
+			expect   interface{}
+		}{
+			{name: "All", operator: func(o Observable) Observable { return o.All(func(i int) bool { return i < 2 }) }, expect: false},
+			{name: "Any", operator: func(o Observable) Observable { return o.Any(func(i int) bool { return i == 2 }) }, expect: true},
+			{name: "Contains", operator: func(o Observable) Observable { return o.Contains(2) }, expect: true},
+			{name: "IsEmpty", operator: func(o Observable) Observable { return o.IsEmpty() }, expect: false},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				emitted := 0
+				source := Create(func(ctx context.Context, ob ObservableEmitter) {
+					for i := 1; i <= 10; i++ {
+						if ob.IsDisposed() {
+							return
+						}
+						emitted++
+						ob.OnNext(ctx, i)
+					}
+					ob.OnComplete(ctx)
+				})
+				ret, err := blockingSingle(tt.operator(source))
+				if !assert.NoError(t, err) {
+					return
+				}
+				assert.Equal(t, tt.expect, ret)
+				assert.LessOrEqual(t, emitted, 2, "should stop the upstream early")
+			})
+		}
+	})
+
+	t.Run("Sum_should_RejectNonNumericSourcesAtAssembly", func(t *testing.T) {
+		sum := FromSlice([]string{"a"}).Sum()
+		_, err := blockingSingle(sum)
+		assert.EqualError(t, err, "Sum expects a numeric source but got string")
+	})
+}
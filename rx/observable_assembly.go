@@ -0,0 +1,137 @@
+package rx
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// AssemblySite is where an Observable was assembled: the factory or operator called, and the caller's position
+type AssemblySite struct {
+	Operator string
+	File     string
+	Line     int
+}
+
+func (s AssemblySite) String() string {
+	return fmt.Sprintf("%s at %s:%d", s.Operator, s.File, s.Line)
+}
+
+var packagePrefix = reflect.TypeOf(BaseObservable{}).PkgPath() + "."
+
+// callerAssemblySite walks the stack up to the first frame outside of the package, the operator is the last function
+// of the package called from there
+func callerAssemblySite() AssemblySite {
+	pcs := make([]uintptr, 32)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs)])
+	var site AssemblySite
+	for {
+		frame, more := frames.Next()
+		if !strings.HasPrefix(frame.Function, packagePrefix) || strings.HasSuffix(frame.File, "_test.go") {
+			site.File, site.Line = frame.File, frame.Line
+			return site
+		}
+		site.Operator = operatorName(strings.TrimPrefix(frame.Function, packagePrefix))
+		if !more {
+			return site
+		}
+	}
+}
+
+// operatorName returns the name of the function or method of a frame, such as Distinct for BaseObservable.Distinct
+// or Just for the closures Just.func1 and Just.func1.2
+func operatorName(function string) string {
+	parts := strings.Split(function, ".")
+	for len(parts) > 1 && isClosureName(parts[len(parts)-1]) {
+		parts = parts[:len(parts)-1]
+	}
+	return parts[len(parts)-1]
+}
+
+func isClosureName(name string) bool {
+	name = strings.TrimPrefix(name, "func")
+	if name == "" {
+		return false
+	}
+	for _, c := range name {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+var _ Observable = (*ObservableOnAssembly)(nil)
+
+// ObservableOnAssembly decorates the errors of the Observable it wraps with the site where it was assembled
+type ObservableOnAssembly struct {
+	BaseObservable
+	source Observable
+	site   AssemblySite
+}
+
+func (o *ObservableOnAssembly) Init() *ObservableOnAssembly {
+	o.Self = func() ObservableSource {
+		return o
+	}
+	return o
+}
+
+func (o *ObservableOnAssembly) Type() reflect.Type {
+	return o.source.Type()
+}
+
+// Site returns where the wrapped Observable was assembled
+func (o *ObservableOnAssembly) Site() AssemblySite {
+	return o.site
+}
+
+func (o *ObservableOnAssembly) subscribeActual(ctx context.Context, ob Observer) {
+	subscribe(ctx, o.source, &assemblyObserver{Observer: ob, site: o.site})
+}
+
+var _ Observer = (*assemblyObserver)(nil)
+
+type assemblyObserver struct {
+	Observer
+	site AssemblySite
+}
+
+func (o *assemblyObserver) OnError(ctx context.Context, err error) {
+	o.Observer.OnError(ctx, withAssemblySite(err, o.site))
+}
+
+var _ ConnectableObservable = (*ConnectableObservableOnAssembly)(nil)
+
+// ConnectableObservableOnAssembly decorates the errors of the ConnectableObservable it wraps with the site where it
+// was assembled. The Observables returned by RefCount and AutoConnect are tracked where they are assembled
+type ConnectableObservableOnAssembly struct {
+	ObservableOnAssembly
+	connectable ConnectableObservable
+}
+
+func newConnectableObservableOnAssembly(connectable ConnectableObservable, site AssemblySite) *ConnectableObservableOnAssembly {
+	o := &ConnectableObservableOnAssembly{
+		ObservableOnAssembly: ObservableOnAssembly{source: connectable, site: site},
+		connectable:          connectable,
+	}
+	o.Self = func() ObservableSource {
+		return o
+	}
+	return o
+}
+
+func (o *ConnectableObservableOnAssembly) Connect(ctx context.Context) Disposable {
+	return o.connectable.Connect(ctx)
+}
+
+func (o *ConnectableObservableOnAssembly) RefCount(minSubscribers int, gracePeriod time.Duration) Observable {
+	return o.connectable.RefCount(minSubscribers, gracePeriod)
+}
+
+func (o *ConnectableObservableOnAssembly) AutoConnect(n int) Observable {
+	return o.connectable.AutoConnect(n)
+}
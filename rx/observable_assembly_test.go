@@ -0,0 +1,102 @@
+package rx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// currentLine returns the line it's called from
+func currentLine() int {
+	_, _, line, _ := runtime.Caller(1)
+	return line
+}
+
+func TestPlugins_SetAssemblyTracking(t *testing.T) {
+	t.Run("SetAssemblyTracking_should_TraceTheAssemblySitesOfTheChain", func(t *testing.T) {
+		t.Cleanup(Plugins.Reset)
+		Plugins.SetAssemblyTracking(true)
+		line := currentLine() + 1
+		source := Create(func(ctx context.Context, e ObservableEmitter) { e.OnError(ctx, errTest) })
+		distinct := source.Distinct()
+		count := distinct.Count()
+		err := count.BlockingForEach(context.Background(), func(int) {})
+
+		assert.True(t, errors.Is(err, errTest))
+		assert.EqualError(t, err, errTest.Error(), "should keep the message of the error")
+		var trace *AssemblyTrace
+		if !assert.True(t, errors.As(err, &trace)) {
+			return
+		}
+		sites := trace.Sites()
+		if !assert.Len(t, sites, 3) {
+			return
+		}
+		for i, operator := range []string{"Create", "Distinct", "Count"} {
+			assert.Equal(t, operator, sites[i].Operator)
+			assert.Equal(t, "observable_assembly_test.go", filepath.Base(sites[i].File))
+			assert.Equal(t, line+i, sites[i].Line)
+		}
+		assert.Equal(t, fmt.Sprintf("test\nassembly trace:\n\t%s\n\t%s\n\t%s", sites[0], sites[1], sites[2]),
+			fmt.Sprintf("%+v", err))
+	})
+
+	t.Run("SetAssemblyTracking_should_NameTheOperatorCalledByTheUser", func(t *testing.T) {
+		t.Cleanup(Plugins.Reset)
+		Plugins.SetAssemblyTracking(true)
+		shared, ok := Just(1).Share().(*ObservableOnAssembly)
+		if assert.True(t, ok) {
+			assert.Equal(t, "Share", shared.Site().Operator)
+		}
+	})
+
+	t.Run("SetAssemblyTracking_should_TrackConnectableObservablesAndFlowables", func(t *testing.T) {
+		t.Cleanup(Plugins.Reset)
+		Plugins.SetAssemblyTracking(true)
+		published, ok := Just(1).Publish().(*ConnectableObservableOnAssembly)
+		if assert.True(t, ok) {
+			assert.Equal(t, "Publish", published.Site().Operator)
+		}
+		err := Create(func(ctx context.Context, e ObservableEmitter) {
+			e.OnError(ctx, errTest)
+		}).ToFlowable(BackpressureBuffer).OnBackpressureLatest().ToObservable().
+			BlockingForEach(context.Background(), func(interface{}) {})
+		var trace *AssemblyTrace
+		if assert.True(t, errors.As(err, &trace)) {
+			var operators []string
+			for _, site := range trace.Sites() {
+				operators = append(operators, site.Operator)
+			}
+			assert.Equal(t, []string{"Create", "ToFlowable", "OnBackpressureLatest", "ToObservable"}, operators)
+		}
+	})
+
+	t.Run("SetAssemblyTracking_should_NotAffectTheItems", func(t *testing.T) {
+		t.Cleanup(Plugins.Reset)
+		Plugins.SetAssemblyTracking(true)
+		var items []int
+		err := Just(1, 2, 2, 3).Distinct().BlockingToSlice(context.Background(), &items)
+		assert.NoError(t, err)
+		assert.Equal(t, []int{1, 2, 3}, items)
+		assert.Equal(t, intType, Just(1).Distinct().Type())
+	})
+
+	t.Run("Reset_should_DisableAssemblyTracking", func(t *testing.T) {
+		Plugins.SetAssemblyTracking(true)
+		Plugins.Reset()
+		err := errorObservable(emptyInterfaceType, errTest).BlockingForEach(context.Background(), func(interface{}) {})
+		assert.Equal(t, errTest, err)
+	})
+}
+
+func TestOperatorName(t *testing.T) {
+	assert.Equal(t, "Distinct", operatorName("BaseObservable.Distinct"))
+	assert.Equal(t, "RefCount", operatorName("(*ObservableMulticast).RefCount"))
+	assert.Equal(t, "Just", operatorName("Just.func1"))
+	assert.Equal(t, "Using", operatorName("Using.func2.1"))
+}
@@ -0,0 +1,268 @@
+package rx
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"sync/atomic"
+
+	"www.github.com/secretworry/rx-go/rx/fun"
+)
+
+// blockingGet waits for the only item emitted by the given observable
+func blockingGet(ctx context.Context, observable Observable) (interface{}, error) {
+	var ret interface{}
+	err := observable.BlockingForEach(ctx, func(v interface{}) {
+		ret = v
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// BlockingFirst waits for the first item emitted by the source, it returns ErrNoSuchElement if the source is empty
+func (b BaseObservable) BlockingFirst(ctx context.Context) (interface{}, error) {
+	return blockingGet(ctx, b.First())
+}
+
+// BlockingLast waits for the last item emitted by the source, it returns ErrNoSuchElement if the source is empty
+func (b BaseObservable) BlockingLast(ctx context.Context) (interface{}, error) {
+	return blockingGet(ctx, b.Last())
+}
+
+// BlockingSingle waits for the only item emitted by the source, it returns ErrNoSuchElement if the source is empty,
+// and ErrSequenceContainsMoreThanOne if it emits more than one item
+func (b BaseObservable) BlockingSingle(ctx context.Context) (interface{}, error) {
+	return blockingGet(ctx, b.Single())
+}
+
+// BlockingToSlice appends every item emitted by the source to the slice pointed by target
+func (b BaseObservable) BlockingToSlice(ctx context.Context, target interface{}) error {
+	if target == nil {
+		return fmt.Errorf("target cannot be nil")
+	}
+	v := reflect.ValueOf(target)
+	if v.Kind() != reflect.Ptr || v.Type().Elem().Kind() != reflect.Slice || v.IsNil() {
+		return fmt.Errorf("target should be a pointer to a slice but got %T", target)
+	}
+	slice := v.Elem()
+	elemType := slice.Type().Elem()
+	return b.BlockingForEach(ctx, func(item interface{}) error {
+		elem, err := assignableValueOf(item, elemType)
+		if err != nil {
+			return err
+		}
+		slice.Set(reflect.Append(slice, elem))
+		return nil
+	})
+}
+
+// BlockingToMap puts every item emitted by the source to the map pointed by target, with the key returned by the
+// keySelector. The item is mapped by the valueSelector before being stored unless valueSelector is nil
+func (b BaseObservable) BlockingToMap(ctx context.Context, target interface{}, keySelector, valueSelector interface{}) error {
+	if target == nil {
+		return fmt.Errorf("target cannot be nil")
+	}
+	v := reflect.ValueOf(target)
+	if v.Kind() != reflect.Ptr || v.Type().Elem().Kind() != reflect.Map || v.IsNil() {
+		return fmt.Errorf("target should be a pointer to a map but got %T", target)
+	}
+	keyCaller, err := fun.CallerOf(keySelector)
+	if err != nil {
+		return err
+	}
+	var valueCaller fun.Caller
+	if valueSelector != nil {
+		valueCaller, err = fun.CallerOf(valueSelector)
+		if err != nil {
+			return err
+		}
+	}
+	m := v.Elem()
+	if m.IsNil() {
+		m.Set(reflect.MakeMap(m.Type()))
+	}
+	keyType, valueType := m.Type().Key(), m.Type().Elem()
+	return b.BlockingForEach(ctx, func(ctx context.Context, item interface{}) error {
+		key, err := keyCaller.Call(ctx, item)
+		if err != nil {
+			return err
+		}
+		value := item
+		if valueCaller != nil {
+			value, err = valueCaller.Call(ctx, item)
+			if err != nil {
+				return err
+			}
+		}
+		k, err := assignableValueOf(key, keyType)
+		if err != nil {
+			return err
+		}
+		e, err := assignableValueOf(value, valueType)
+		if err != nil {
+			return err
+		}
+		m.SetMapIndex(k, e)
+		return nil
+	})
+}
+
+func assignableValueOf(item interface{}, t reflect.Type) (reflect.Value, error) {
+	if item == nil {
+		switch t.Kind() {
+		case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Ptr, reflect.Slice:
+			return reflect.Zero(t), nil
+		default:
+			return reflect.Value{}, fmt.Errorf("cannot use nil as %s", t)
+		}
+	}
+	v := reflect.ValueOf(item)
+	if !v.Type().AssignableTo(t) {
+		return reflect.Value{}, fmt.Errorf("cannot use %s as %s", v.Type(), t)
+	}
+	return v, nil
+}
+
+// BlockingSubscribe subscribes to the source and waits for its termination, calling the given callbacks for
+// every signal. onNext is shaped like func([ctx context.Context,] item T) [error], an error returned by it
+// disposes the source and is passed to onError, so is the *CancelledError if ctx is done first.
+// Any callback can be nil
+func (b BaseObservable) BlockingSubscribe(ctx context.Context, onNext interface{}, onError func(err error), onComplete func()) {
+	if onNext == nil {
+		onNext = func(interface{}) {}
+	}
+	if err := b.BlockingForEach(ctx, onNext); err != nil {
+		if onError != nil {
+			onError(err)
+		}
+	} else if onComplete != nil {
+		onComplete()
+	}
+}
+
+// Iterator pulls the items of an Observable one at a time
+type Iterator interface {
+	// Next blocks until the next item is available, it returns false once the source terminates or the iterator is
+	// closed
+	Next() bool
+	// Value returns the item fetched by the last call to Next
+	Value() interface{}
+	// Err returns the error terminating the source, or a *CancelledError if the context is done first
+	Err() error
+	// Close disposes the source, it's safe to call Close more than once
+	Close()
+}
+
+// BlockingIterable subscribes to the source on a new goroutine and returns an Iterator over its items.
+// At most prefetch items are buffered ahead, the source is blocked until the Iterator consumes them
+func (b BaseObservable) BlockingIterable(ctx context.Context, prefetch int) Iterator {
+	if prefetch < 0 {
+		prefetch = 0
+	}
+	it := &blockingIterator{
+		ctx:    ctx,
+		items:  make(chan interface{}, prefetch),
+		closed: make(chan struct{}),
+	}
+	source := b.Self()
+	schedule(func() {
+		subscribe(ctx, source, it)
+	})
+	return it
+}
+
+var _ Iterator = (*blockingIterator)(nil)
+var _ Observer = (*blockingIterator)(nil)
+
+type blockingIterator struct {
+	ctx       context.Context
+	upstream  DisposableRef
+	items     chan interface{}
+	closed    chan struct{}
+	closeOnce sync.Once
+	done      int32
+	err       error
+	// the following fields are only accessed by the consuming goroutine
+	value     interface{}
+	consumed  int
+	exhausted bool
+	ctxErr    error
+}
+
+func (it *blockingIterator) Type() reflect.Type {
+	return emptyInterfaceType
+}
+
+func (it *blockingIterator) OnSubscribe(disposable Disposable) {
+	it.upstream.SetOnce(disposable)
+}
+
+func (it *blockingIterator) OnNext(ctx context.Context, msg interface{}) {
+	if atomic.LoadInt32(&it.done) == 1 {
+		return
+	}
+	select {
+	case it.items <- msg:
+	case <-it.closed:
+	case <-ctx.Done():
+	}
+}
+
+func (it *blockingIterator) OnError(ctx context.Context, err error) {
+	if atomic.CompareAndSwapInt32(&it.done, 0, 1) {
+		it.err = err
+		close(it.items)
+	}
+}
+
+func (it *blockingIterator) OnComplete(ctx context.Context) {
+	if atomic.CompareAndSwapInt32(&it.done, 0, 1) {
+		close(it.items)
+	}
+}
+
+func (it *blockingIterator) Next() bool {
+	select {
+	case <-it.closed:
+		return false
+	default:
+	}
+	select {
+	case v, ok := <-it.items:
+		if !ok {
+			it.value = nil
+			it.exhausted = true
+			return false
+		}
+		it.value = v
+		it.consumed++
+		return true
+	case <-it.closed:
+		return false
+	case <-it.ctx.Done():
+		it.ctxErr = &CancelledError{Err: it.ctx.Err(), Consumed: it.consumed}
+		it.Close()
+		return false
+	}
+}
+
+func (it *blockingIterator) Value() interface{} {
+	return it.value
+}
+
+func (it *blockingIterator) Err() error {
+	if it.exhausted {
+		return it.err
+	}
+	return it.ctxErr
+}
+
+func (it *blockingIterator) Close() {
+	it.closeOnce.Do(func() {
+		close(it.closed)
+		it.upstream.Dispose()
+	})
+}
@@ -0,0 +1,161 @@
+package rx
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBaseObservable_BlockingGet(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name      string
+		get       func() (interface{}, error)
+		expect    interface{}
+		expectErr error
+	}{
+		{name: "BlockingFirst", get: func() (interface{}, error) { return Just(1, 2).BlockingFirst(ctx) }, expect: 1},
+		{name: "BlockingFirstEmpty", get: func() (interface{}, error) { return Just().BlockingFirst(ctx) }, expectErr: ErrNoSuchElement},
+		{name: "BlockingLast", get: func() (interface{}, error) { return Just(1, 2).BlockingLast(ctx) }, expect: 2},
+		{name: "BlockingSingle", get: func() (interface{}, error) { return Just(1).BlockingSingle(ctx) }, expect: 1},
+		{name: "BlockingSingleMoreThanOne", get: func() (interface{}, error) { return Just(1, 2).BlockingSingle(ctx) }, expectErr: ErrSequenceContainsMoreThanOne},
+		{name: "BlockingSingleNil", get: func() (interface{}, error) { return Just(nil).BlockingSingle(ctx) }, expect: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ret, err := tt.get()
+			if tt.expectErr != nil {
+				assert.True(t, errors.Is(err, tt.expectErr), "expect %v but got %v", tt.expectErr, err)
+				return
+			} else if !assert.NoError(t, err) {
+				return
+			}
+			assert.Equal(t, tt.expect, ret)
+		})
+	}
+}
+
+func TestBaseObservable_BlockingToSlice(t *testing.T) {
+	t.Run("BlockingToSlice_should_AppendItemsToTheSlice", func(t *testing.T) {
+		ret := []int{0}
+		err := Just(1, 2).BlockingToSlice(context.Background(), &ret)
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, []int{0, 1, 2}, ret)
+	})
+
+	t.Run("BlockingToSlice_should_RejectInvalidTargets", func(t *testing.T) {
+		err := Just(1).BlockingToSlice(context.Background(), []int{})
+		assert.EqualError(t, err, "target should be a pointer to a slice but got []int")
+	})
+
+	t.Run("BlockingToSlice_should_FailOnUnassignableItems", func(t *testing.T) {
+		ret := new([]int)
+		err := Just(1, "a").BlockingToSlice(context.Background(), ret)
+		assert.EqualError(t, err, "cannot use string as int")
+	})
+}
+
+func TestBaseObservable_BlockingToMap(t *testing.T) {
+	t.Run("BlockingToMap_should_PutItemsWithTheirKeys", func(t *testing.T) {
+		var ret map[string]int
+		err := Just(1, 2).BlockingToMap(context.Background(), &ret, strconv.Itoa, nil)
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, map[string]int{"1": 1, "2": 2}, ret)
+	})
+
+	t.Run("BlockingToMap_should_ApplyTheValueSelector", func(t *testing.T) {
+		ret := map[int]string{}
+		err := Just(1, 2).BlockingToMap(context.Background(), &ret, func(i int) int { return i }, strconv.Itoa)
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, map[int]string{1: "1", 2: "2"}, ret)
+	})
+}
+
+func TestBaseObservable_BlockingSubscribe(t *testing.T) {
+	t.Run("BlockingSubscribe_should_CallTheCallbacks", func(t *testing.T) {
+		var items []int
+		completed := false
+		Just(1, 2).BlockingSubscribe(context.Background(), func(i int) {
+			items = append(items, i)
+		}, func(err error) {
+			assert.Fail(t, "should not call onError")
+		}, func() {
+			completed = true
+		})
+		assert.Equal(t, []int{1, 2}, items)
+		assert.True(t, completed)
+	})
+
+	t.Run("BlockingSubscribe_should_PassErrorsToOnError", func(t *testing.T) {
+		var ret error
+		errorObservable(emptyInterfaceType, errTest).BlockingSubscribe(context.Background(), nil, func(err error) {
+			ret = err
+		}, func() {
+			assert.Fail(t, "should not call onComplete")
+		})
+		assert.Equal(t, errTest, ret)
+	})
+}
+
+func TestBaseObservable_BlockingIterable(t *testing.T) {
+	t.Run("BlockingIterable_should_IterateOverTheItems", func(t *testing.T) {
+		it := Just(1, 2, 3).BlockingIterable(context.Background(), 1)
+		defer it.Close()
+		var items []interface{}
+		for it.Next() {
+			items = append(items, it.Value())
+		}
+		assert.NoError(t, it.Err())
+		assert.Equal(t, []interface{}{1, 2, 3}, items)
+	})
+
+	t.Run("BlockingIterable_should_ReportTheError", func(t *testing.T) {
+		it := errorObservable(emptyInterfaceType, errTest).BlockingIterable(context.Background(), 1)
+		defer it.Close()
+		assert.False(t, it.Next())
+		assert.Equal(t, errTest, it.Err())
+	})
+
+	t.Run("BlockingIterable_should_PrefetchAtMostTheGivenNumberOfItems", func(t *testing.T) {
+		emitted := make(chan int, 10)
+		stopped := make(chan struct{})
+		it := Create(func(ctx context.Context, ob ObservableEmitter) {
+			defer close(stopped)
+			for i := 0; i < 10 && !ob.IsDisposed(); i++ {
+				emitted <- i
+				ob.OnNext(ctx, i)
+			}
+			ob.OnComplete(ctx)
+		}).BlockingIterable(context.Background(), 2)
+		if !assert.True(t, it.Next()) {
+			return
+		}
+		it.Close()
+		<-stopped
+		assert.LessOrEqual(t, len(emitted), 5, "should stop the source once closed")
+		assert.False(t, it.Next(), "should not return items after closed")
+	})
+
+	t.Run("BlockingIterable_should_StopOnContextCancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		it := Create(func(ctx context.Context, ob ObservableEmitter) {
+			<-ctx.Done()
+		}).BlockingIterable(ctx, 1)
+		cancel()
+		assert.False(t, it.Next())
+		var cancelled *CancelledError
+		if assert.ErrorAs(t, it.Err(), &cancelled) {
+			assert.Equal(t, context.Canceled, cancelled.Err)
+			assert.Equal(t, 0, cancelled.Consumed)
+		}
+	})
+}
@@ -0,0 +1,174 @@
+package rx
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// FromChannel creates an Observable emitting the items received from the given channel, it completes once the
+// channel is closed. The channel can be of any type as long as it can receive, and Type reports its element type
+func FromChannel(ch interface{}) Observable {
+	if ch == nil {
+		return errorObservable(emptyInterfaceType, fmt.Errorf("channel cannot be nil"))
+	}
+	v := reflect.ValueOf(ch)
+	if v.Kind() != reflect.Chan || v.Type().ChanDir()&reflect.RecvDir == 0 {
+		return errorObservable(emptyInterfaceType, fmt.Errorf("channel should be a receivable channel but got %T", ch))
+	}
+	return onAssembly((&ObservableFromChannel{
+		ch: v,
+	}).Init())
+}
+
+var _ Observable = (*ObservableFromChannel)(nil)
+
+type ObservableFromChannel struct {
+	BaseObservable
+	ch reflect.Value
+}
+
+func (o *ObservableFromChannel) Init() *ObservableFromChannel {
+	o.Self = func() ObservableSource {
+		return o
+	}
+	return o
+}
+
+func (o *ObservableFromChannel) Type() reflect.Type {
+	return o.ch.Type().Elem()
+}
+
+func (o *ObservableFromChannel) subscribeActual(ctx context.Context, ob Observer) {
+	disposed := make(chan struct{})
+	disposable := Disposables.FromFunc(func() {
+		close(disposed)
+	})
+	ob.OnSubscribe(disposable)
+	cases := []reflect.SelectCase{
+		{Dir: reflect.SelectRecv, Chan: o.ch},
+		{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(ctx.Done())},
+		{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(disposed)},
+	}
+	// reflect.Select picks a random ready case, so disposal is checked first to stop receiving right away
+	for !disposable.IsDisposed() && !isDone(ctx) {
+		chosen, v, ok := reflect.Select(cases)
+		if chosen != 0 {
+			return
+		}
+		if !ok {
+			ob.OnComplete(ctx)
+			return
+		}
+		ob.OnNext(ctx, v.Interface())
+	}
+}
+
+// ToChannel subscribes to the source on a new goroutine and sends its items to the returned channel, typed as
+// <-chan T where T is the Type of the source. The error terminating the source is sent to the error channel.
+// Both channels are closed once the source terminates, or once ctx is done, which disposes the source.
+// A bufferSize not positive makes the items channel unbuffered
+func (b BaseObservable) ToChannel(ctx context.Context, bufferSize int) (interface{}, <-chan error) {
+	if bufferSize < 0 {
+		bufferSize = 0
+	}
+	source := b.Self()
+	elemType := source.Type()
+	items := reflect.MakeChan(reflect.ChanOf(reflect.BothDir, elemType), bufferSize)
+	errs := make(chan error, 1)
+	ob := &toChannelObserver{
+		elemType: elemType,
+		items:    items,
+		errs:     errs,
+		done:     ctx.Done(),
+		closed:   make(chan struct{}),
+	}
+	schedule(ob.watch)
+	schedule(func() {
+		subscribe(ctx, source, ob)
+	})
+	return items.Convert(reflect.ChanOf(reflect.RecvDir, elemType)).Interface(), errs
+}
+
+var _ Observer = (*toChannelObserver)(nil)
+
+type toChannelObserver struct {
+	elemType reflect.Type
+	upstream DisposableRef
+	items    reflect.Value
+	errs     chan error
+	done     <-chan struct{}
+	closed   chan struct{}
+
+	// mu guards the channels from being closed while sending
+	mu         sync.Mutex
+	terminated bool
+}
+
+func (o *toChannelObserver) Type() reflect.Type {
+	return o.elemType
+}
+
+// watch closes the channels once the context is done, even if the source never terminates
+func (o *toChannelObserver) watch() {
+	select {
+	case <-o.done:
+		o.upstream.Dispose()
+		o.close(nil)
+	case <-o.closed:
+	}
+}
+
+func (o *toChannelObserver) OnSubscribe(disposable Disposable) {
+	o.upstream.SetOnce(disposable)
+}
+
+func (o *toChannelObserver) OnNext(ctx context.Context, msg interface{}) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.terminated {
+		return
+	}
+	v, err := assignableValueOf(msg, o.elemType)
+	if err != nil {
+		o.upstream.Dispose()
+		o.closeLocked(err)
+		return
+	}
+	chosen, _, _ := reflect.Select([]reflect.SelectCase{
+		{Dir: reflect.SelectSend, Chan: o.items, Send: v},
+		{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(o.done)},
+	})
+	if chosen != 0 {
+		o.upstream.Dispose()
+		o.closeLocked(nil)
+	}
+}
+
+func (o *toChannelObserver) OnError(ctx context.Context, err error) {
+	o.close(err)
+}
+
+func (o *toChannelObserver) OnComplete(ctx context.Context) {
+	o.close(nil)
+}
+
+func (o *toChannelObserver) close(err error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.closeLocked(err)
+}
+
+func (o *toChannelObserver) closeLocked(err error) {
+	if o.terminated {
+		return
+	}
+	o.terminated = true
+	if err != nil {
+		o.errs <- err
+	}
+	close(o.errs)
+	o.items.Close()
+	close(o.closed)
+}
@@ -0,0 +1,134 @@
+package rx
+
+import (
+	"context"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromChannel(t *testing.T) {
+	t.Run("FromChannel_should_EmitItemsUntilTheChannelIsClosed", func(t *testing.T) {
+		ch := make(chan int, 3)
+		ch <- 1
+		ch <- 2
+		ch <- 3
+		close(ch)
+		ret := new([]int)
+		err := FromChannel(ch).BlockingToSlice(context.Background(), ret)
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, []int{1, 2, 3}, *ret)
+	})
+
+	t.Run("FromChannel_should_ReportTheElementType", func(t *testing.T) {
+		assert.Equal(t, reflect.TypeOf(""), FromChannel(make(<-chan string)).Type())
+	})
+
+	t.Run("FromChannel_should_RejectSendOnlyChannels", func(t *testing.T) {
+		err := FromChannel(make(chan<- int)).BlockingForEach(context.Background(), func(i int) {})
+		assert.EqualError(t, err, "channel should be a receivable channel but got chan<- int")
+	})
+
+	t.Run("FromChannel_should_StopReceivingOnceDisposed", func(t *testing.T) {
+		ch := make(chan int, 3)
+		ch <- 1
+		ch <- 2
+		ch <- 3
+		ret, err := FromChannel(ch).BlockingFirst(context.Background())
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, 1, ret)
+		assert.Len(t, ch, 2, "should leave the remaining items in the channel")
+	})
+
+	t.Run("FromChannel_should_StopReceivingOnceTheContextIsDone", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		err := FromChannel(make(chan int)).BlockingForEach(ctx, func(i int) {})
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
+
+func TestBaseObservable_ToChannel(t *testing.T) {
+	t.Run("ToChannel_should_SendItemsToATypedChannel", func(t *testing.T) {
+		ch := make(chan int, 2)
+		ch <- 1
+		ch <- 2
+		close(ch)
+		items, errs := FromChannel(ch).ToChannel(context.Background(), 0)
+		typed, ok := items.(<-chan int)
+		if !assert.True(t, ok, "expect <-chan int but got %T", items) {
+			return
+		}
+		var ret []int
+		for i := range typed {
+			ret = append(ret, i)
+		}
+		assert.Equal(t, []int{1, 2}, ret)
+		assert.NoError(t, <-errs)
+	})
+
+	t.Run("ToChannel_should_TreatANegativeBufferSizeAsUnbuffered", func(t *testing.T) {
+		var items interface{}
+		var errs <-chan error
+		if !assert.NotPanics(t, func() {
+			items, errs = Just(1, 2).ToChannel(context.Background(), -1)
+		}) {
+			return
+		}
+		typed := items.(<-chan int)
+		assert.Equal(t, 0, cap(typed))
+		var ret []int
+		for i := range typed {
+			ret = append(ret, i)
+		}
+		assert.Equal(t, []int{1, 2}, ret)
+		assert.NoError(t, <-errs)
+	})
+
+	t.Run("ToChannel_should_RunItsGoroutinesThroughTheScheduleHook", func(t *testing.T) {
+		t.Cleanup(Plugins.Reset)
+		var scheduled int32
+		Plugins.SetScheduleHandler(func(run func()) func() {
+			atomic.AddInt32(&scheduled, 1)
+			return run
+		})
+		items, errs := Just(1).ToChannel(context.Background(), 1)
+		for range items.(<-chan int) {
+		}
+		assert.NoError(t, <-errs)
+		assert.Equal(t, int32(2), atomic.LoadInt32(&scheduled), "should schedule the subscription and the watcher")
+	})
+
+	t.Run("ToChannel_should_SendTheErrorToTheErrorChannel", func(t *testing.T) {
+		items, errs := errorObservable(emptyInterfaceType, errTest).ToChannel(context.Background(), 0)
+		_, ok := <-items.(<-chan interface{})
+		assert.False(t, ok, "should close the item channel")
+		assert.Equal(t, errTest, <-errs)
+	})
+
+	t.Run("ToChannel_should_CloseTheChannelsOnceTheContextIsDone", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		disposed := make(chan struct{})
+		items, errs := Create(func(ctx context.Context, ob ObservableEmitter) {
+			ob.SetDisposable(Disposables.FromFunc(func() {
+				close(disposed)
+			}))
+			ob.OnNext(ctx, 1)
+		}).ToChannel(ctx, 0)
+		typed := items.(<-chan interface{})
+		assert.Equal(t, 1, <-typed)
+		cancel()
+		for range typed {
+		}
+		_, ok := <-errs
+		assert.False(t, ok, "should close the error channel")
+		<-disposed
+	})
+}
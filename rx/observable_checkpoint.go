@@ -0,0 +1,56 @@
+package rx
+
+import (
+	"context"
+	"reflect"
+)
+
+// Checkpoint emits the signals of the source, and wraps the errors going through it in a *CheckpointError carrying
+// the given description and where Checkpoint was called. Unlike assembly tracking, it only costs a stack walk on
+// assembly of this very operator
+func (b BaseObservable) Checkpoint(description string) Observable {
+	return onAssembly((&ObservableCheckpoint{
+		source:      b.Self(),
+		description: description,
+		site:        callerAssemblySite(),
+	}).Init())
+}
+
+var _ Observable = (*ObservableCheckpoint)(nil)
+
+type ObservableCheckpoint struct {
+	BaseObservable
+	source      ObservableSource
+	description string
+	site        AssemblySite
+}
+
+func (o *ObservableCheckpoint) Init() *ObservableCheckpoint {
+	o.Self = func() ObservableSource {
+		return o
+	}
+	return o
+}
+
+func (o *ObservableCheckpoint) Type() reflect.Type {
+	return o.source.Type()
+}
+
+func (o *ObservableCheckpoint) subscribeActual(ctx context.Context, ob Observer) {
+	subscribe(ctx, o.source, &checkpointObserver{Observer: ob, checkpoint: o})
+}
+
+var _ Observer = (*checkpointObserver)(nil)
+
+type checkpointObserver struct {
+	Observer
+	checkpoint *ObservableCheckpoint
+}
+
+func (o *checkpointObserver) OnError(ctx context.Context, err error) {
+	o.Observer.OnError(ctx, &CheckpointError{
+		Description: o.checkpoint.description,
+		Site:        o.checkpoint.site,
+		Err:         err,
+	})
+}
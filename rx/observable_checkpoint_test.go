@@ -0,0 +1,51 @@
+package rx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBaseObservable_Checkpoint(t *testing.T) {
+	t.Run("Checkpoint_should_WrapErrorsWithItsDescriptionAndSite", func(t *testing.T) {
+		line := currentLine() + 1
+		err := errorObservable(emptyInterfaceType, errTest).Checkpoint("load users").BlockingForEach(context.Background(), func(interface{}) {})
+
+		assert.True(t, errors.Is(err, errTest), "should unwrap to the original error")
+		var checkpoint *CheckpointError
+		if !assert.True(t, errors.As(err, &checkpoint)) {
+			return
+		}
+		assert.Equal(t, "load users", checkpoint.Description)
+		assert.Equal(t, "Checkpoint", checkpoint.Site.Operator)
+		assert.Equal(t, "observable_checkpoint_test.go", filepath.Base(checkpoint.Site.File))
+		assert.Equal(t, line, checkpoint.Site.Line)
+		assert.EqualError(t, err, fmt.Sprintf("checkpoint \"load users\" at %s:%d: test", checkpoint.Site.File, line))
+	})
+
+	t.Run("Checkpoint_should_NestAlongTheChain", func(t *testing.T) {
+		err := errorObservable(emptyInterfaceType, errTest).Checkpoint("inner").Distinct().Checkpoint("outer").
+			BlockingForEach(context.Background(), func(interface{}) {})
+		var outer *CheckpointError
+		if !assert.True(t, errors.As(err, &outer)) {
+			return
+		}
+		assert.Equal(t, "outer", outer.Description)
+		var inner *CheckpointError
+		if assert.True(t, errors.As(outer.Err, &inner)) {
+			assert.Equal(t, "inner", inner.Description)
+			assert.Equal(t, errTest, inner.Err)
+		}
+	})
+
+	t.Run("Checkpoint_should_PassItemsThrough", func(t *testing.T) {
+		var items []int
+		err := Just(1, 2, 3).Checkpoint("items").BlockingToSlice(context.Background(), &items)
+		assert.NoError(t, err)
+		assert.Equal(t, []int{1, 2, 3}, items)
+	})
+}
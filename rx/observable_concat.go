@@ -0,0 +1,111 @@
+package rx
+
+import (
+	"context"
+	"reflect"
+	"sync/atomic"
+)
+
+// ConcatDelayError subscribes to the sources one after another, each once the previous one terminates, and emits
+// their items in order. An error of a source does not stop the next ones, every error is signaled once the last
+// source terminates, as a *CompositeError if there are more than one
+func ConcatDelayError(sources ...ObservableSource) Observable {
+	return onAssembly((&ObservableConcatDelayError{
+		sources: sources,
+	}).Init())
+}
+
+var _ Observable = (*ObservableConcatDelayError)(nil)
+
+type ObservableConcatDelayError struct {
+	BaseObservable
+	sources []ObservableSource
+}
+
+func (o *ObservableConcatDelayError) Init() *ObservableConcatDelayError {
+	o.Self = func() ObservableSource {
+		return o
+	}
+	return o
+}
+
+func (o *ObservableConcatDelayError) Type() reflect.Type {
+	return commonSourceType(o.sources)
+}
+
+func (o *ObservableConcatDelayError) subscribeActual(ctx context.Context, ob Observer) {
+	c := &concatDelayErrorCoordinator{
+		actual:  ob,
+		sources: o.sources,
+		ctx:     ctx,
+		serial:  NewSerialDisposable(nil),
+	}
+	ob.OnSubscribe(c.serial)
+	c.next(ctx)
+}
+
+// concatDelayErrorCoordinator subscribes to the next source each time the current one terminates. The sources
+// terminating synchronously are subscribed to in a loop instead of recursively, by the first call of next
+type concatDelayErrorCoordinator struct {
+	actual  Observer
+	sources []ObservableSource
+	ctx     context.Context
+	serial  *SerialDisposable
+	index   int
+	wip     int32
+	// errs is appended by the current source before it calls next, so it's never accessed concurrently
+	errs []error
+}
+
+func (c *concatDelayErrorCoordinator) next(ctx context.Context) {
+	if atomic.AddInt32(&c.wip, 1) != 1 {
+		return
+	}
+	for {
+		if c.serial.IsDisposed() {
+			return
+		}
+		if c.index == len(c.sources) {
+			if err := compositeErrorOf(c.errs); err != nil {
+				c.actual.OnError(ctx, err)
+			} else {
+				c.actual.OnComplete(ctx)
+			}
+			return
+		}
+		source := c.sources[c.index]
+		c.index++
+		subscribe(c.ctx, source, &concatInnerObserver{parent: c})
+		if atomic.AddInt32(&c.wip, -1) == 0 {
+			return
+		}
+	}
+}
+
+var _ Observer = (*concatInnerObserver)(nil)
+
+type concatInnerObserver struct {
+	parent *concatDelayErrorCoordinator
+}
+
+func (o *concatInnerObserver) Type() reflect.Type {
+	return o.parent.actual.Type()
+}
+
+func (o *concatInnerObserver) OnSubscribe(disposable Disposable) {
+	// the previous source is terminated, there's nothing left to dispose
+	o.parent.serial.Replace(disposable)
+}
+
+func (o *concatInnerObserver) OnNext(ctx context.Context, msg interface{}) {
+	o.parent.actual.OnNext(ctx, msg)
+}
+
+func (o *concatInnerObserver) OnError(ctx context.Context, err error) {
+	o.parent.errs = append(o.parent.errs, err)
+	o.parent.next(ctx)
+}
+
+func (o *concatInnerObserver) OnComplete(ctx context.Context) {
+	o.parent.next(ctx)
+}
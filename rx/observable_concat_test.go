@@ -0,0 +1,52 @@
+package rx
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConcatDelayError(t *testing.T) {
+	other := errors.New("other")
+
+	t.Run("ConcatDelayError_should_EmitTheSourcesInOrder", func(t *testing.T) {
+		var items []int
+		err := ConcatDelayError(Just(1, 2), Just(3), Just(4, 5)).BlockingToSlice(context.Background(), &items)
+		assert.NoError(t, err)
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, items)
+	})
+
+	t.Run("ConcatDelayError_should_ReportEveryErrorAfterTheLastSource", func(t *testing.T) {
+		ob := newTestObserver()
+		ConcatDelayError(Just(1), errorObservable(emptyInterfaceType, errTest), Just(2), errorObservable(emptyInterfaceType, other), Just(3)).Subscribe(context.Background(), ob)
+		assert.Equal(t, []interface{}{1, 2, 3}, ob.Items())
+		var composite *CompositeError
+		if assert.True(t, errors.As(ob.Err(), &composite)) {
+			assert.Equal(t, []error{errTest, other}, composite.Errors())
+		}
+	})
+
+	t.Run("ConcatDelayError_should_WaitForAsynchronousSources", func(t *testing.T) {
+		ob := newTestObserver()
+		ConcatDelayError(asyncRange(2), Just(2)).Subscribe(context.Background(), ob)
+		<-ob.done
+		assert.Equal(t, []interface{}{0, 1, 2}, ob.Items())
+		assert.True(t, ob.Completed())
+	})
+
+	t.Run("ConcatDelayError_should_StopSubscribingOnceDisposed", func(t *testing.T) {
+		subscribed := false
+		ob := newTestObserver()
+		ConcatDelayError(Create(func(ctx context.Context, emitter ObservableEmitter) {
+			emitter.OnNext(ctx, 1)
+		}), Create(func(ctx context.Context, emitter ObservableEmitter) {
+			subscribed = true
+		})).Subscribe(context.Background(), ob)
+		ob.Dispose()
+		assert.False(t, subscribed)
+		assert.False(t, ob.Completed())
+		assert.Equal(t, []interface{}{1}, ob.Items())
+	})
+}
@@ -0,0 +1,506 @@
+package rx
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ConnectableObservable shares a single subscription to its source among all its observers.
+// The source is only subscribed when Connect is called, rather than when observers subscribe
+type ConnectableObservable interface {
+	Observable
+	// Connect subscribes to the source unless it is already connected. Disposing the returned Disposable
+	// disposes the subscription to the source
+	Connect(ctx context.Context) Disposable
+	// RefCount connects once minSubscribers observers are subscribed, and disposes the connection gracePeriod
+	// after the last observer is gone
+	RefCount(minSubscribers int, gracePeriod time.Duration) Observable
+	// AutoConnect connects once the nth observer subscribes, and never disposes the connection.
+	// A n not positive connects on the first subscription, like 1, so that the source is subscribed with the
+	// context of an observer rather than while assembling
+	AutoConnect(n int) Observable
+}
+
+// Publish returns a ConnectableObservable emitting the items of the source to the observers subscribed when they
+// are emitted
+func (b BaseObservable) Publish() ConnectableObservable {
+	return onConnectableAssembly((&ObservableMulticast{
+		source: b.Self(),
+	}).Init())
+}
+
+// Replay returns a ConnectableObservable replaying the items emitted by the source to every observer, including
+// the ones subscribed after they were emitted. At most bufferSize items emitted within window are replayed,
+// a bufferSize or window not positive means no limit
+func (b BaseObservable) Replay(bufferSize int, window time.Duration) ConnectableObservable {
+	return onConnectableAssembly((&ObservableMulticast{
+		source:     b.Self(),
+		replay:     true,
+		bufferSize: bufferSize,
+		window:     window,
+	}).Init())
+}
+
+// Share subscribes to the source once for all its concurrent observers, it's a shortcut of Publish().RefCount(1, 0)
+func (b BaseObservable) Share() Observable {
+	return b.Publish().RefCount(1, 0)
+}
+
+// Cache subscribes to the source on the first subscription, and replays all its items to every observer.
+// It's a shortcut of Replay(0, 0).AutoConnect(1)
+func (b BaseObservable) Cache() Observable {
+	return b.Replay(0, 0).AutoConnect(1)
+}
+
+var _ ConnectableObservable = (*ObservableMulticast)(nil)
+
+type ObservableMulticast struct {
+	BaseObservable
+	source     ObservableSource
+	replay     bool
+	bufferSize int
+	window     time.Duration
+
+	mu      sync.Mutex
+	current *multicastConnection
+}
+
+func (o *ObservableMulticast) Init() *ObservableMulticast {
+	o.Self = func() ObservableSource {
+		return o
+	}
+	return o
+}
+
+func (o *ObservableMulticast) Type() reflect.Type {
+	return o.source.Type()
+}
+
+func (o *ObservableMulticast) subscribeActual(ctx context.Context, ob Observer) {
+	o.mu.Lock()
+	// a replaying connection keeps replaying after it terminates, until a new connection starts
+	if o.current == nil || o.current.IsDisposed() || (o.current.isTerminated() && !o.replay) {
+		o.current = o.newConnection()
+	}
+	connection := o.current
+	o.mu.Unlock()
+	connection.add(ctx, ob)
+}
+
+func (o *ObservableMulticast) Connect(ctx context.Context) Disposable {
+	connection := o.nextConnection()
+	connection.connect(ctx)
+	return connection
+}
+
+// nextConnection returns the connection for the next Connect, which is the current one unless it's terminated
+func (o *ObservableMulticast) nextConnection() *multicastConnection {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.current == nil || o.current.IsDisposed() || o.current.isTerminated() {
+		o.current = o.newConnection()
+	}
+	return o.current
+}
+
+func (o *ObservableMulticast) newConnection() *multicastConnection {
+	c := &multicastConnection{parent: o}
+	if o.replay {
+		c.buffer = &replayBuffer{
+			size:   o.bufferSize,
+			window: o.window,
+			now:    time.Now,
+		}
+	}
+	return c
+}
+
+func (o *ObservableMulticast) RefCount(minSubscribers int, gracePeriod time.Duration) Observable {
+	return onAssembly((&ObservableRefCount{
+		source:         o,
+		minSubscribers: minSubscribers,
+		gracePeriod:    gracePeriod,
+	}).Init())
+}
+
+func (o *ObservableMulticast) AutoConnect(n int) Observable {
+	if n <= 0 {
+		n = 1
+	}
+	return onAssembly((&ObservableAutoConnect{
+		source: o,
+		n:      int32(n),
+	}).Init())
+}
+
+var _ Disposable = (*multicastConnection)(nil)
+var _ Observer = (*multicastConnection)(nil)
+
+// multicastConnection is a subscription to the source of an ObservableMulticast, relaying its signals to all the
+// observers subscribed to it
+type multicastConnection struct {
+	parent    *ObservableMulticast
+	upstream  DisposableRef
+	connected int32
+
+	mu         sync.Mutex
+	observers  []*multicastObserver
+	buffer     *replayBuffer
+	terminated bool
+	err        error
+}
+
+func (c *multicastConnection) connect(ctx context.Context) {
+	if atomic.CompareAndSwapInt32(&c.connected, 0, 1) {
+		subscribe(ctx, c.parent.source, c)
+	}
+}
+
+func (c *multicastConnection) Dispose() {
+	c.upstream.Dispose()
+}
+
+func (c *multicastConnection) IsDisposed() bool {
+	return c.upstream.IsDisposed()
+}
+
+func (c *multicastConnection) isTerminated() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.terminated
+}
+
+func (c *multicastConnection) Type() reflect.Type {
+	return c.parent.source.Type()
+}
+
+func (c *multicastConnection) OnSubscribe(disposable Disposable) {
+	c.upstream.SetOnce(disposable)
+}
+
+func (c *multicastConnection) OnNext(ctx context.Context, msg interface{}) {
+	c.signal(signal{value: msg}, false)
+}
+
+func (c *multicastConnection) OnError(ctx context.Context, err error) {
+	c.signal(signal{err: err, done: true}, true)
+}
+
+func (c *multicastConnection) OnComplete(ctx context.Context) {
+	c.signal(signal{done: true}, true)
+}
+
+func (c *multicastConnection) signal(s signal, terminal bool) {
+	c.mu.Lock()
+	if c.terminated {
+		c.mu.Unlock()
+		return
+	}
+	if terminal {
+		c.terminated = true
+		c.err = s.err
+	} else if c.buffer != nil {
+		c.buffer.add(s.value)
+	}
+	observers := c.observers
+	for _, ob := range observers {
+		ob.enqueue(s)
+	}
+	if terminal {
+		c.observers = nil
+	}
+	c.mu.Unlock()
+	for _, ob := range observers {
+		ob.drain()
+	}
+}
+
+func (c *multicastConnection) add(ctx context.Context, actual Observer) {
+	ob := &multicastObserver{
+		connection: c,
+		actual:     actual,
+		ctx:        ctx,
+	}
+	actual.OnSubscribe(ob)
+	c.mu.Lock()
+	if c.buffer != nil {
+		for _, item := range c.buffer.items() {
+			ob.enqueue(signal{value: item})
+		}
+	}
+	if c.terminated {
+		ob.enqueue(signal{err: c.err, done: true})
+	} else {
+		c.observers = append(c.observers[:len(c.observers):len(c.observers)], ob)
+	}
+	c.mu.Unlock()
+	ob.drain()
+}
+
+func (c *multicastConnection) remove(ob *multicastObserver) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i, o := range c.observers {
+		if o == ob {
+			observers := make([]*multicastObserver, 0, len(c.observers)-1)
+			observers = append(observers, c.observers[:i]...)
+			c.observers = append(observers, c.observers[i+1:]...)
+			return
+		}
+	}
+}
+
+// signal is an item or a terminal signal queued for an observer
+type signal struct {
+	value interface{}
+	err   error
+	done  bool
+}
+
+var _ Disposable = (*multicastObserver)(nil)
+
+// multicastObserver queues the signals of a connection for one observer, and delivers them in order without
+// holding any lock
+type multicastObserver struct {
+	connection *multicastConnection
+	actual     Observer
+	ctx        context.Context
+	disposed   int32
+
+	mu       sync.Mutex
+	queue    []signal
+	emitting bool
+}
+
+func (o *multicastObserver) Dispose() {
+	if atomic.CompareAndSwapInt32(&o.disposed, 0, 1) {
+		o.connection.remove(o)
+	}
+}
+
+func (o *multicastObserver) IsDisposed() bool {
+	return atomic.LoadInt32(&o.disposed) == 1
+}
+
+func (o *multicastObserver) enqueue(s signal) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.queue = append(o.queue, s)
+}
+
+func (o *multicastObserver) drain() {
+	o.mu.Lock()
+	if o.emitting {
+		o.mu.Unlock()
+		return
+	}
+	o.emitting = true
+	for len(o.queue) > 0 {
+		queue := o.queue
+		o.queue = nil
+		o.mu.Unlock()
+		for _, s := range queue {
+			if o.IsDisposed() || isDone(o.ctx) {
+				break
+			}
+			switch {
+			case !s.done:
+				o.actual.OnNext(o.ctx, s.value)
+			case s.err != nil:
+				o.actual.OnError(o.ctx, s.err)
+			default:
+				o.actual.OnComplete(o.ctx)
+			}
+		}
+		o.mu.Lock()
+	}
+	o.emitting = false
+	o.mu.Unlock()
+}
+
+// replayBuffer keeps the items to replay, bounded by size and by the window they were emitted in
+type replayBuffer struct {
+	size   int
+	window time.Duration
+	now    func() time.Time
+	values []interface{}
+	times  []time.Time
+}
+
+func (b *replayBuffer) add(value interface{}) {
+	b.values = append(b.values, value)
+	b.times = append(b.times, b.now())
+	b.trim()
+}
+
+func (b *replayBuffer) items() []interface{} {
+	b.trim()
+	return b.values[:len(b.values):len(b.values)]
+}
+
+func (b *replayBuffer) trim() {
+	drop := 0
+	if b.size > 0 && len(b.values) > b.size {
+		drop = len(b.values) - b.size
+	}
+	if b.window > 0 {
+		now := b.now()
+		for drop < len(b.times) && now.Sub(b.times[drop]) > b.window {
+			drop++
+		}
+	}
+	if drop > 0 {
+		b.values = append([]interface{}(nil), b.values[drop:]...)
+		b.times = append([]time.Time(nil), b.times[drop:]...)
+	}
+}
+
+var _ Observable = (*ObservableRefCount)(nil)
+
+type ObservableRefCount struct {
+	BaseObservable
+	source         *ObservableMulticast
+	minSubscribers int
+	gracePeriod    time.Duration
+
+	mu         sync.Mutex
+	count      int
+	connection *multicastConnection
+	timer      *time.Timer
+}
+
+func (o *ObservableRefCount) Init() *ObservableRefCount {
+	o.Self = func() ObservableSource {
+		return o
+	}
+	return o
+}
+
+func (o *ObservableRefCount) Type() reflect.Type {
+	return o.source.Type()
+}
+
+func (o *ObservableRefCount) subscribeActual(ctx context.Context, ob Observer) {
+	o.mu.Lock()
+	o.count++
+	if o.timer != nil {
+		o.timer.Stop()
+		o.timer = nil
+	}
+	var connection *multicastConnection
+	if (o.connection == nil || o.connection.isTerminated()) && o.count >= o.minSubscribers {
+		connection = o.source.nextConnection()
+		o.connection = connection
+	}
+	o.mu.Unlock()
+	subscribe(ctx, o.source, &refCountObserver{
+		actual: ob,
+		parent: o,
+	})
+	if connection != nil {
+		// the connection is shared, so it should outlive the context of the observer triggering it
+		connection.connect(context.WithoutCancel(ctx))
+	}
+}
+
+func (o *ObservableRefCount) release() {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.count--
+	if o.count > 0 || o.connection == nil {
+		return
+	}
+	connection := o.connection
+	if o.gracePeriod <= 0 {
+		o.connection = nil
+		connection.Dispose()
+		return
+	}
+	o.timer = time.AfterFunc(o.gracePeriod, onSchedule(func() {
+		o.mu.Lock()
+		defer o.mu.Unlock()
+		if o.count == 0 && o.connection == connection {
+			o.connection = nil
+			o.timer = nil
+			connection.Dispose()
+		}
+	}))
+}
+
+var _ Disposable = (*refCountObserver)(nil)
+var _ Observer = (*refCountObserver)(nil)
+
+type refCountObserver struct {
+	actual   Observer
+	parent   *ObservableRefCount
+	upstream Disposable
+	released int32
+}
+
+func (o *refCountObserver) Type() reflect.Type {
+	return o.actual.Type()
+}
+
+func (o *refCountObserver) Dispose() {
+	o.upstream.Dispose()
+	o.release()
+}
+
+func (o *refCountObserver) IsDisposed() bool {
+	return o.upstream.IsDisposed()
+}
+
+func (o *refCountObserver) release() {
+	if atomic.CompareAndSwapInt32(&o.released, 0, 1) {
+		o.parent.release()
+	}
+}
+
+func (o *refCountObserver) OnSubscribe(disposable Disposable) {
+	o.upstream = disposable
+	o.actual.OnSubscribe(o)
+}
+
+func (o *refCountObserver) OnNext(ctx context.Context, msg interface{}) {
+	o.actual.OnNext(ctx, msg)
+}
+
+func (o *refCountObserver) OnError(ctx context.Context, err error) {
+	o.release()
+	o.actual.OnError(ctx, err)
+}
+
+func (o *refCountObserver) OnComplete(ctx context.Context) {
+	o.release()
+	o.actual.OnComplete(ctx)
+}
+
+var _ Observable = (*ObservableAutoConnect)(nil)
+
+type ObservableAutoConnect struct {
+	BaseObservable
+	source *ObservableMulticast
+	n      int32
+	count  int32
+}
+
+func (o *ObservableAutoConnect) Init() *ObservableAutoConnect {
+	o.Self = func() ObservableSource {
+		return o
+	}
+	return o
+}
+
+func (o *ObservableAutoConnect) Type() reflect.Type {
+	return o.source.Type()
+}
+
+func (o *ObservableAutoConnect) subscribeActual(ctx context.Context, ob Observer) {
+	subscribe(ctx, o.source, ob)
+	if atomic.AddInt32(&o.count, 1) == o.n {
+		o.source.Connect(context.WithoutCancel(ctx))
+	}
+}
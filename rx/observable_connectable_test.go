@@ -0,0 +1,260 @@
+package rx
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// countingSource emits the given items and counts its subscriptions
+func countingSource(subscriptions *int32, items ...interface{}) Observable {
+	return Create(func(ctx context.Context, ob ObservableEmitter) {
+		atomic.AddInt32(subscriptions, 1)
+		for _, item := range items {
+			ob.OnNext(ctx, item)
+		}
+		ob.OnComplete(ctx)
+	})
+}
+
+func TestBaseObservable_Publish(t *testing.T) {
+	t.Run("Publish_should_NotSubscribeBeforeConnect", func(t *testing.T) {
+		ctx := context.Background()
+		var subscriptions int32
+		published := countingSource(&subscriptions, 1, 2).Publish()
+		ob := newTestObserver()
+		published.Subscribe(ctx, ob)
+		assert.Equal(t, int32(0), atomic.LoadInt32(&subscriptions))
+		assert.Empty(t, ob.Items())
+	})
+
+	t.Run("Publish_should_ShareTheSubscriptionAmongObservers", func(t *testing.T) {
+		ctx := context.Background()
+		var subscriptions int32
+		published := countingSource(&subscriptions, 1, 2, 3).Publish()
+		first, second := newTestObserver(), newTestObserver()
+		published.Subscribe(ctx, first)
+		published.Subscribe(ctx, second)
+		published.Connect(ctx)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&subscriptions))
+		assert.Equal(t, []interface{}{1, 2, 3}, first.Items())
+		assert.Equal(t, []interface{}{1, 2, 3}, second.Items())
+		assert.True(t, first.Completed())
+		assert.True(t, second.Completed())
+	})
+
+	t.Run("Publish_should_OnlyEmitItemsAfterSubscription", func(t *testing.T) {
+		ctx := context.Background()
+		var emitter ObservableEmitter
+		published := Create(func(ctx context.Context, ob ObservableEmitter) {
+			emitter = ob
+		}).Publish()
+		first := newTestObserver()
+		published.Subscribe(ctx, first)
+		published.Connect(ctx)
+		emitter.OnNext(ctx, 1)
+		second := newTestObserver()
+		published.Subscribe(ctx, second)
+		emitter.OnNext(ctx, 2)
+		emitter.OnComplete(ctx)
+		assert.Equal(t, []interface{}{1, 2}, first.Items())
+		assert.Equal(t, []interface{}{2}, second.Items())
+	})
+
+	t.Run("Publish_should_StopEmittingToDisposedObservers", func(t *testing.T) {
+		ctx := context.Background()
+		var emitter ObservableEmitter
+		published := Create(func(ctx context.Context, ob ObservableEmitter) {
+			emitter = ob
+		}).Publish()
+		ob := newTestObserver()
+		published.Subscribe(ctx, ob)
+		published.Connect(ctx)
+		emitter.OnNext(ctx, 1)
+		ob.Dispose()
+		emitter.OnNext(ctx, 2)
+		assert.Equal(t, []interface{}{1}, ob.Items())
+	})
+
+	t.Run("Publish_should_DisposeTheSourceWithTheConnection", func(t *testing.T) {
+		ctx := context.Background()
+		var emitter ObservableEmitter
+		published := Create(func(ctx context.Context, ob ObservableEmitter) {
+			emitter = ob
+		}).Publish()
+		connection := published.Connect(ctx)
+		connection.Dispose()
+		assert.True(t, emitter.IsDisposed())
+	})
+
+	t.Run("Publish_should_ReconnectAfterTermination", func(t *testing.T) {
+		ctx := context.Background()
+		var subscriptions int32
+		published := countingSource(&subscriptions, 1).Publish()
+		published.Connect(ctx)
+		ob := newTestObserver()
+		published.Subscribe(ctx, ob)
+		assert.Empty(t, ob.Items(), "should wait for the next connection")
+		published.Connect(ctx)
+		assert.Equal(t, int32(2), atomic.LoadInt32(&subscriptions))
+		assert.Equal(t, []interface{}{1}, ob.Items())
+	})
+}
+
+func TestBaseObservable_Replay(t *testing.T) {
+	t.Run("Replay_should_ReplayItemsToLateObservers", func(t *testing.T) {
+		ctx := context.Background()
+		replayed := Just(1, 2, 3).Replay(0, 0)
+		replayed.Connect(ctx)
+		ob := newTestObserver()
+		replayed.Subscribe(ctx, ob)
+		assert.Equal(t, []interface{}{1, 2, 3}, ob.Items())
+		assert.True(t, ob.Completed(), "should replay the completion")
+	})
+
+	t.Run("Replay_should_ReplayTheError", func(t *testing.T) {
+		ctx := context.Background()
+		replayed := errorObservable(emptyInterfaceType, errTest).Replay(0, 0)
+		replayed.Connect(ctx)
+		ob := newTestObserver()
+		replayed.Subscribe(ctx, ob)
+		assert.Equal(t, errTest, ob.Err())
+	})
+
+	t.Run("Replay_should_BoundTheBufferBySize", func(t *testing.T) {
+		ctx := context.Background()
+		replayed := Just(1, 2, 3, 4).Replay(2, 0)
+		replayed.Connect(ctx)
+		ob := newTestObserver()
+		replayed.Subscribe(ctx, ob)
+		assert.Equal(t, []interface{}{3, 4}, ob.Items())
+	})
+
+	t.Run("Replay_should_BoundTheBufferByWindow", func(t *testing.T) {
+		now := time.Unix(0, 0)
+		buffer := &replayBuffer{window: time.Second, now: func() time.Time { return now }}
+		buffer.add(1)
+		now = now.Add(600 * time.Millisecond)
+		buffer.add(2)
+		now = now.Add(600 * time.Millisecond)
+		assert.Equal(t, []interface{}{2}, buffer.items())
+	})
+}
+
+func TestObservableMulticast_RefCount(t *testing.T) {
+	t.Run("RefCount_should_ConnectOnceEnoughObserversSubscribed", func(t *testing.T) {
+		ctx := context.Background()
+		var subscriptions int32
+		shared := countingSource(&subscriptions, 1, 2).Publish().RefCount(2, 0)
+		first, second := newTestObserver(), newTestObserver()
+		shared.Subscribe(ctx, first)
+		assert.Equal(t, int32(0), atomic.LoadInt32(&subscriptions))
+		shared.Subscribe(ctx, second)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&subscriptions))
+		assert.Equal(t, []interface{}{1, 2}, first.Items())
+		assert.Equal(t, []interface{}{1, 2}, second.Items())
+	})
+
+	t.Run("RefCount_should_DisposeTheConnectionWithTheLastObserver", func(t *testing.T) {
+		ctx := context.Background()
+		var emitter ObservableEmitter
+		shared := Create(func(ctx context.Context, ob ObservableEmitter) {
+			emitter = ob
+		}).Share()
+		first, second := newTestObserver(), newTestObserver()
+		shared.Subscribe(ctx, first)
+		shared.Subscribe(ctx, second)
+		first.Dispose()
+		assert.False(t, emitter.IsDisposed())
+		second.Dispose()
+		assert.True(t, emitter.IsDisposed())
+	})
+
+	t.Run("RefCount_should_KeepTheConnectionDuringTheGracePeriod", func(t *testing.T) {
+		ctx := context.Background()
+		var subscriptions int32
+		var emitter ObservableEmitter
+		shared := Create(func(ctx context.Context, ob ObservableEmitter) {
+			atomic.AddInt32(&subscriptions, 1)
+			emitter = ob
+		}).Publish().RefCount(1, time.Hour)
+		first := newTestObserver()
+		shared.Subscribe(ctx, first)
+		first.Dispose()
+		shared.Subscribe(ctx, newTestObserver())
+		assert.False(t, emitter.IsDisposed())
+		assert.Equal(t, int32(1), atomic.LoadInt32(&subscriptions))
+	})
+
+	t.Run("RefCount_should_DisposeTheConnectionAfterTheGracePeriod", func(t *testing.T) {
+		ctx := context.Background()
+		disposed := make(chan struct{})
+		shared := Create(func(ctx context.Context, ob ObservableEmitter) {
+			ob.SetDisposable(Disposables.FromFunc(func() { close(disposed) }))
+		}).Publish().RefCount(1, 10*time.Millisecond)
+		ob := newTestObserver()
+		shared.Subscribe(ctx, ob)
+		ob.Dispose()
+		select {
+		case <-disposed:
+		case <-time.After(time.Second):
+			assert.Fail(t, "should dispose the connection after the grace period")
+		}
+	})
+
+	t.Run("Share_should_ResubscribeAfterTermination", func(t *testing.T) {
+		ctx := context.Background()
+		var subscriptions int32
+		shared := countingSource(&subscriptions, 1).Share()
+		for i := 0; i < 2; i++ {
+			ob := newTestObserver()
+			shared.Subscribe(ctx, ob)
+			assert.Equal(t, []interface{}{1}, ob.Items())
+		}
+		assert.Equal(t, int32(2), atomic.LoadInt32(&subscriptions))
+	})
+}
+
+func TestObservableMulticast_AutoConnect(t *testing.T) {
+	t.Run("AutoConnect_should_ConnectOnTheNthObserver", func(t *testing.T) {
+		ctx := context.Background()
+		var subscriptions int32
+		connected := countingSource(&subscriptions, 1).Publish().AutoConnect(2)
+		first, second := newTestObserver(), newTestObserver()
+		connected.Subscribe(ctx, first)
+		assert.Equal(t, int32(0), atomic.LoadInt32(&subscriptions))
+		connected.Subscribe(ctx, second)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&subscriptions))
+		assert.Equal(t, []interface{}{1}, first.Items())
+		assert.Equal(t, []interface{}{1}, second.Items())
+	})
+
+	t.Run("AutoConnect_should_ConnectOnTheFirstObserverIfNIsNotPositive", func(t *testing.T) {
+		ctx := context.Background()
+		var subscriptions int32
+		connected := countingSource(&subscriptions, 1).Publish().AutoConnect(0)
+		assert.Equal(t, int32(0), atomic.LoadInt32(&subscriptions), "should not connect while assembling")
+		ob := newTestObserver()
+		connected.Subscribe(ctx, ob)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&subscriptions))
+		assert.Equal(t, []interface{}{1}, ob.Items())
+		assert.True(t, ob.Completed())
+	})
+
+	t.Run("Cache_should_SubscribeOnceAndReplayToEveryObserver", func(t *testing.T) {
+		ctx := context.Background()
+		var subscriptions int32
+		cached := countingSource(&subscriptions, 1, 2).Cache()
+		for i := 0; i < 3; i++ {
+			var items []int
+			if !assert.NoError(t, cached.BlockingToSlice(ctx, &items)) {
+				return
+			}
+			assert.Equal(t, []int{1, 2}, items)
+		}
+		assert.Equal(t, int32(1), atomic.LoadInt32(&subscriptions))
+	})
+}
@@ -0,0 +1,323 @@
+package rx
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"www.github.com/secretworry/rx-go/rx/fun"
+)
+
+var boolType = reflect.TypeOf((*bool)(nil)).Elem()
+
+type DistinctOption func(options *distinctOptions)
+
+type distinctOptions struct {
+	keySelector interface{}
+	comparer    interface{}
+	cache       func() DistinctCache
+	// capacity and ttl are the bounds set by DistinctLRU and DistinctExpiring, which also apply to the keys compared
+	// with a DistinctComparer. customCache is set by DistinctCacheFactory, whose cache cannot use a comparer
+	capacity    int
+	ttl         time.Duration
+	customCache bool
+	err         error
+}
+
+// DistinctKeySelector compares the keys computed from the items instead of the items themselves
+func DistinctKeySelector(keySelector interface{}) DistinctOption {
+	return func(options *distinctOptions) {
+		options.keySelector = keySelector
+	}
+}
+
+// DistinctComparer tells whether two keys are equal. It's a function shaped like
+// func([ctx context.Context,] a, b T) (bool[, error]).
+// DistinctUntilChanged compares keys of comparable types with == and the others with reflect.DeepEqual by default.
+// Distinct compares each key with every key it remembers, instead of looking them up in its DistinctCache, so its
+// keys need not be comparable but each item costs a comparison per remembered key. DistinctLRU and DistinctExpiring
+// bound the keys remembered as usual, while a DistinctCacheFactory cannot be combined with a comparer
+func DistinctComparer(comparer interface{}) DistinctOption {
+	return func(options *distinctOptions) {
+		options.comparer = comparer
+	}
+}
+
+// DistinctCacheFactory creates the cache remembering the keys seen by each subscription to Distinct.
+// NewUnboundedDistinctCache is used by default
+func DistinctCacheFactory(factory func() DistinctCache) DistinctOption {
+	return func(options *distinctOptions) {
+		options.cache = factory
+		options.capacity, options.ttl, options.customCache = 0, 0, true
+	}
+}
+
+// DistinctLRU bounds the keys remembered by Distinct to the given capacity, which should be positive
+func DistinctLRU(capacity int) DistinctOption {
+	return func(options *distinctOptions) {
+		if capacity <= 0 {
+			options.err = fmt.Errorf("capacity should be positive but got %d", capacity)
+			return
+		}
+		options.cache = func() DistinctCache {
+			return NewLRUDistinctCache(capacity)
+		}
+		options.capacity, options.ttl, options.customCache = capacity, 0, false
+	}
+}
+
+// DistinctExpiring makes Distinct forget a key once it has not been seen for the given duration, which should be
+// positive
+func DistinctExpiring(ttl time.Duration) DistinctOption {
+	return func(options *distinctOptions) {
+		if ttl <= 0 {
+			options.err = fmt.Errorf("ttl should be positive but got %s", ttl)
+			return
+		}
+		options.cache = func() DistinctCache {
+			return NewExpiringDistinctCache(ttl)
+		}
+		options.capacity, options.ttl, options.customCache = 0, ttl, false
+	}
+}
+
+func newDistinctOptions(opts []DistinctOption) (distinctOptions, fun.Caller, error) {
+	options := distinctOptions{
+		cache: NewUnboundedDistinctCache,
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	if options.err != nil {
+		return options, nil, options.err
+	}
+	if options.keySelector == nil {
+		return options, nil, nil
+	}
+	keySelector, err := fun.CallerOf(options.keySelector)
+	return options, keySelector, err
+}
+
+func distinctComparerOf(comparer interface{}) (fun.BiCaller, error) {
+	caller, err := fun.BiCallerOf(comparer)
+	if err != nil {
+		return nil, err
+	}
+	if caller.ReturnType() != boolType {
+		return nil, fmt.Errorf("comparer should return bool but got %s", caller.ReturnType())
+	}
+	return caller, nil
+}
+
+func selectKey(ctx context.Context, keySelector fun.Caller, msg interface{}) (interface{}, error) {
+	if keySelector == nil {
+		return msg, nil
+	}
+	return keySelector.Call(ctx, msg)
+}
+
+func (b BaseObservable) Distinct(opts ...DistinctOption) Observable {
+	source := b.Self()
+	options, keySelector, err := newDistinctOptions(opts)
+	if err != nil {
+		return sourceErrorObservable(source, err)
+	}
+	var comparer fun.BiCaller
+	if options.comparer != nil {
+		if options.customCache {
+			return sourceErrorObservable(source, fmt.Errorf("a DistinctComparer cannot be used with a DistinctCacheFactory"))
+		}
+		comparer, err = distinctComparerOf(options.comparer)
+		if err != nil {
+			return sourceErrorObservable(source, err)
+		}
+	}
+	return onAssembly((&ObservableDistinct{
+		source:      source,
+		keySelector: keySelector,
+		comparer:    comparer,
+		cache:       options.cache,
+		capacity:    options.capacity,
+		ttl:         options.ttl,
+	}).Init())
+}
+
+var _ Observable = (*ObservableDistinct)(nil)
+
+type ObservableDistinct struct {
+	BaseObservable
+	source      ObservableSource
+	keySelector fun.Caller
+	comparer    fun.BiCaller
+	cache       func() DistinctCache
+	capacity    int
+	ttl         time.Duration
+}
+
+func (o *ObservableDistinct) Init() *ObservableDistinct {
+	o.Self = func() ObservableSource {
+		return o
+	}
+	return o
+}
+
+func (o *ObservableDistinct) Type() reflect.Type {
+	return o.source.Type()
+}
+
+func (o *ObservableDistinct) subscribeActual(ctx context.Context, ob Observer) {
+	observer := &distinctObserver{
+		basicObserver: basicObserver{actual: ob},
+		parent:        o,
+	}
+	if o.comparer != nil {
+		observer.compared = newComparedDistinctCache(o.comparer, o.capacity, o.ttl)
+	} else {
+		observer.cache = o.cache()
+	}
+	subscribe(ctx, o.source, observer)
+}
+
+var _ Observer = (*distinctObserver)(nil)
+
+type distinctObserver struct {
+	basicObserver
+	parent *ObservableDistinct
+	cache  DistinctCache
+	// compared remembers the keys in place of cache when they are compared with a DistinctComparer
+	compared *comparedDistinctCache
+}
+
+func (o *distinctObserver) Type() reflect.Type {
+	return o.actual.Type()
+}
+
+func (o *distinctObserver) OnNext(ctx context.Context, msg interface{}) {
+	if o.isTerminated() || isDone(ctx) {
+		return
+	}
+	key, err := selectKey(ctx, o.parent.keySelector, msg)
+	if err != nil {
+		o.fail(ctx, err)
+		return
+	}
+	if o.compared != nil {
+		o.onNextCompared(ctx, key, msg)
+		return
+	}
+	if !isComparable(key) {
+		o.fail(ctx, fmt.Errorf("distinct key should be comparable but got %T", key))
+		return
+	}
+	if o.cache.Add(key) {
+		o.actual.OnNext(ctx, msg)
+	}
+}
+
+func (o *distinctObserver) onNextCompared(ctx context.Context, key, msg interface{}) {
+	added, err := o.compared.add(ctx, key)
+	if err != nil {
+		o.fail(ctx, err)
+		return
+	}
+	if added {
+		o.actual.OnNext(ctx, msg)
+	}
+}
+
+func (b BaseObservable) DistinctUntilChanged(opts ...DistinctOption) Observable {
+	source := b.Self()
+	options, keySelector, err := newDistinctOptions(opts)
+	if err != nil {
+		return sourceErrorObservable(source, err)
+	}
+	var comparer fun.BiCaller
+	if options.comparer != nil {
+		comparer, err = distinctComparerOf(options.comparer)
+		if err != nil {
+			return sourceErrorObservable(source, err)
+		}
+	}
+	return onAssembly((&ObservableDistinctUntilChanged{
+		source:      source,
+		keySelector: keySelector,
+		comparer:    comparer,
+	}).Init())
+}
+
+var _ Observable = (*ObservableDistinctUntilChanged)(nil)
+
+type ObservableDistinctUntilChanged struct {
+	BaseObservable
+	source      ObservableSource
+	keySelector fun.Caller
+	comparer    fun.BiCaller
+}
+
+func (o *ObservableDistinctUntilChanged) Init() *ObservableDistinctUntilChanged {
+	o.Self = func() ObservableSource {
+		return o
+	}
+	return o
+}
+
+func (o *ObservableDistinctUntilChanged) Type() reflect.Type {
+	return o.source.Type()
+}
+
+func (o *ObservableDistinctUntilChanged) subscribeActual(ctx context.Context, ob Observer) {
+	subscribe(ctx, o.source, &distinctUntilChangedObserver{
+		basicObserver: basicObserver{actual: ob},
+		parent:        o,
+	})
+}
+
+func (o *ObservableDistinctUntilChanged) equal(ctx context.Context, a, b interface{}) (bool, error) {
+	if o.comparer != nil {
+		equal, err := o.comparer.Call(ctx, a, b)
+		if err != nil {
+			return false, err
+		}
+		return equal.(bool), nil
+	}
+	return equalValues(a, b), nil
+}
+
+var _ Observer = (*distinctUntilChangedObserver)(nil)
+
+type distinctUntilChangedObserver struct {
+	basicObserver
+	parent  *ObservableDistinctUntilChanged
+	last    interface{}
+	hasLast bool
+}
+
+func (o *distinctUntilChangedObserver) Type() reflect.Type {
+	return o.actual.Type()
+}
+
+func (o *distinctUntilChangedObserver) OnNext(ctx context.Context, msg interface{}) {
+	if o.isTerminated() || isDone(ctx) {
+		return
+	}
+	key, err := selectKey(ctx, o.parent.keySelector, msg)
+	if err != nil {
+		o.fail(ctx, err)
+		return
+	}
+	if o.hasLast {
+		equal, err := o.parent.equal(ctx, o.last, key)
+		if err != nil {
+			o.fail(ctx, err)
+			return
+		}
+		if equal {
+			o.last = key
+			return
+		}
+	}
+	o.last = key
+	o.hasLast = true
+	o.actual.OnNext(ctx, msg)
+}
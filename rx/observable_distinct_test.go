@@ -0,0 +1,162 @@
+package rx
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBaseObservable_Distinct(t *testing.T) {
+	t.Run("Distinct_should_DropItemsSeenBefore", func(t *testing.T) {
+		ret := new([]int)
+		err := Just(1, 2, 1, 3, 2, 4).Distinct().BlockingToSlice(context.Background(), ret)
+		if !assert.NoError(t, err, "should BlockingForEach without error") {
+			return
+		}
+		assert.Equal(t, []int{1, 2, 3, 4}, *ret)
+	})
+
+	t.Run("Distinct_should_CompareTheSelectedKeys", func(t *testing.T) {
+		ret := new([]string)
+		err := Just("a", "B", "A", "b", "c").Distinct(DistinctKeySelector(strings.ToLower)).
+			BlockingToSlice(context.Background(), ret)
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, []string{"a", "B", "c"}, *ret)
+	})
+
+	t.Run("Distinct_should_ForgetKeysEvictedFromTheCache", func(t *testing.T) {
+		ret := new([]int)
+		err := Just(1, 2, 3, 1).Distinct(DistinctLRU(2)).BlockingToSlice(context.Background(), ret)
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, []int{1, 2, 3, 1}, *ret)
+	})
+
+	t.Run("Distinct_should_UseAFreshCachePerSubscription", func(t *testing.T) {
+		ctx := context.Background()
+		distinct := Just(1, 1, 2).Distinct()
+		for i := 0; i < 2; i++ {
+			ret := new([]int)
+			if !assert.NoError(t, distinct.BlockingToSlice(ctx, ret)) {
+				return
+			}
+			assert.Equal(t, []int{1, 2}, *ret)
+		}
+	})
+
+	t.Run("Distinct_should_FailOnNonComparableKeys", func(t *testing.T) {
+		err := Just([]int{1}).Distinct().BlockingForEach(context.Background(), func(v []int) {})
+		assert.EqualError(t, err, "distinct key should be comparable but got []int")
+	})
+
+	t.Run("Distinct_should_FailOnKeysHoldingNonComparableValues", func(t *testing.T) {
+		err := Just(1).Distinct(DistinctKeySelector(func(i int) [1]interface{} {
+			return [1]interface{}{[]int{i}}
+		})).BlockingForEach(context.Background(), func(v int) {})
+		assert.EqualError(t, err, "distinct key should be comparable but got [1]interface {}")
+	})
+
+	t.Run("Distinct_should_UseTheComparer", func(t *testing.T) {
+		ret := new([][]int)
+		err := Just([]int{1}, []int{2}, []int{1}, []int{3, 2}).Distinct(DistinctComparer(func(a, b []int) bool {
+			return a[len(a)-1] == b[len(b)-1]
+		})).BlockingToSlice(context.Background(), ret)
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, [][]int{{1}, {2}}, *ret)
+	})
+
+	t.Run("Distinct_should_BoundTheKeysComparedWithTheComparer", func(t *testing.T) {
+		ret := new([]int)
+		err := Just(1, 2, 3, 11, 4, 12).Distinct(
+			DistinctComparer(func(a, b int) bool { return a%10 == b%10 }),
+			DistinctLRU(2),
+		).BlockingToSlice(context.Background(), ret)
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, []int{1, 2, 3, 11, 4, 12}, *ret, "should forget the keys evicted from the LRU")
+	})
+
+	t.Run("Distinct_should_RejectAComparerWithACacheFactory", func(t *testing.T) {
+		err := Just(1).Distinct(
+			DistinctComparer(func(a, b int) bool { return a == b }),
+			DistinctCacheFactory(NewUnboundedDistinctCache),
+		).BlockingForEach(context.Background(), func(v int) {})
+		assert.EqualError(t, err, "a DistinctComparer cannot be used with a DistinctCacheFactory")
+	})
+
+	t.Run("Distinct_should_RejectNonPositiveBounds", func(t *testing.T) {
+		ctx := context.Background()
+		err := Just(1).Distinct(DistinctLRU(0)).BlockingForEach(ctx, func(v int) {})
+		assert.EqualError(t, err, "capacity should be positive but got 0")
+		err = Just(1).Distinct(DistinctExpiring(-time.Second)).BlockingForEach(ctx, func(v int) {})
+		assert.EqualError(t, err, "ttl should be positive but got -1s")
+	})
+
+	t.Run("Distinct_should_PropagateComparerErrors", func(t *testing.T) {
+		err := Just(1, 2).Distinct(DistinctComparer(func(a, b int) (bool, error) {
+			return false, errTest
+		})).BlockingForEach(context.Background(), func(v int) {})
+		assert.Equal(t, errTest, err)
+	})
+
+	t.Run("Distinct_should_RejectInvalidKeySelectors", func(t *testing.T) {
+		err := Just(1).Distinct(DistinctKeySelector(1)).BlockingForEach(context.Background(), func(v int) {})
+		assert.EqualError(t, err, "call should be a function")
+	})
+}
+
+func TestBaseObservable_DistinctUntilChanged(t *testing.T) {
+	t.Run("DistinctUntilChanged_should_DropConsecutiveDuplicates", func(t *testing.T) {
+		ret := new([]int)
+		err := Just(1, 1, 2, 2, 1, 3, 3).DistinctUntilChanged().BlockingToSlice(context.Background(), ret)
+		if !assert.NoError(t, err, "should BlockingForEach without error") {
+			return
+		}
+		assert.Equal(t, []int{1, 2, 1, 3}, *ret)
+	})
+
+	t.Run("DistinctUntilChanged_should_CompareNonComparableItemsDeeply", func(t *testing.T) {
+		ret := new([][]int)
+		err := Just([]int{1}, []int{1}, []int{2}).DistinctUntilChanged().
+			BlockingToSlice(context.Background(), ret)
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, [][]int{{1}, {2}}, *ret)
+	})
+
+	t.Run("DistinctUntilChanged_should_UseTheComparer", func(t *testing.T) {
+		ret := new([]int)
+		err := Just(1, 2, 4, 5, 9).DistinctUntilChanged(
+			DistinctKeySelector(func(i int) int { return i * 10 }),
+			DistinctComparer(func(a, b int) bool { return b-a <= 10 }),
+		).BlockingToSlice(context.Background(), ret)
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, []int{1, 4, 9}, *ret)
+	})
+
+	t.Run("DistinctUntilChanged_should_PropagateComparerErrors", func(t *testing.T) {
+		err := Just(1, 2).DistinctUntilChanged(DistinctComparer(func(a, b int) (bool, error) {
+			return false, errTest
+		})).BlockingForEach(context.Background(), func(v int) {})
+		assert.Equal(t, errTest, err)
+	})
+
+	t.Run("DistinctUntilChanged_should_RejectComparersNotReturningBool", func(t *testing.T) {
+		err := Just(1).DistinctUntilChanged(DistinctComparer(func(a, b int) int {
+			return a - b
+		})).BlockingForEach(context.Background(), func(v int) {})
+		assert.EqualError(t, err, "comparer should return bool but got int")
+	})
+}
@@ -0,0 +1,270 @@
+package rx
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+)
+
+// First emits the first item emitted by the source, or signals ErrNoSuchElement if the source is empty
+func (b BaseObservable) First() Observable {
+	source := b.Self()
+	return newAggregate(source, source.Type(), func() aggregator {
+		return &elementAtAggregator{index: 0}
+	})
+}
+
+// FirstOrDefault emits the first item emitted by the source, or the given value if the source is empty
+func (b BaseObservable) FirstOrDefault(value interface{}) Observable {
+	source := b.Self()
+	return newAggregate(source, source.Type(), func() aggregator {
+		return &elementAtAggregator{index: 0, defaultValue: value, hasDefault: true}
+	})
+}
+
+// ElementAt emits the item at the given zero-based index, or signals ErrNoSuchElement if the source emits fewer items
+func (b BaseObservable) ElementAt(index int) Observable {
+	source := b.Self()
+	if index < 0 {
+		return sourceErrorObservable(source, fmt.Errorf("index should not be negative but got %d", index))
+	}
+	return newAggregate(source, source.Type(), func() aggregator {
+		return &elementAtAggregator{index: index}
+	})
+}
+
+type elementAtAggregator struct {
+	index        int
+	count        int
+	element      interface{}
+	found        bool
+	defaultValue interface{}
+	hasDefault   bool
+}
+
+func (a *elementAtAggregator) next(ctx context.Context, msg interface{}) (bool, error) {
+	if a.count == a.index {
+		a.element = msg
+		a.found = true
+		return true, nil
+	}
+	a.count++
+	return false, nil
+}
+
+func (a *elementAtAggregator) result() (interface{}, bool, error) {
+	switch {
+	case a.found:
+		return a.element, true, nil
+	case a.hasDefault:
+		return a.defaultValue, true, nil
+	default:
+		return nil, false, ErrNoSuchElement
+	}
+}
+
+// Last emits the last item emitted by the source, or signals ErrNoSuchElement if the source is empty
+func (b BaseObservable) Last() Observable {
+	source := b.Self()
+	return newAggregate(source, source.Type(), func() aggregator {
+		return &lastAggregator{}
+	})
+}
+
+type lastAggregator struct {
+	last  interface{}
+	found bool
+}
+
+func (a *lastAggregator) next(ctx context.Context, msg interface{}) (bool, error) {
+	a.last = msg
+	a.found = true
+	return false, nil
+}
+
+func (a *lastAggregator) result() (interface{}, bool, error) {
+	if !a.found {
+		return nil, false, ErrNoSuchElement
+	}
+	return a.last, true, nil
+}
+
+// Single emits the only item emitted by the source. It signals ErrNoSuchElement if the source is empty, and
+// ErrSequenceContainsMoreThanOne as soon as the source emits a second item
+func (b BaseObservable) Single() Observable {
+	source := b.Self()
+	return newAggregate(source, source.Type(), func() aggregator {
+		return &singleAggregator{}
+	})
+}
+
+type singleAggregator struct {
+	element interface{}
+	found   bool
+}
+
+func (a *singleAggregator) next(ctx context.Context, msg interface{}) (bool, error) {
+	if a.found {
+		return false, ErrSequenceContainsMoreThanOne
+	}
+	a.element = msg
+	a.found = true
+	return false, nil
+}
+
+func (a *singleAggregator) result() (interface{}, bool, error) {
+	if !a.found {
+		return nil, false, ErrNoSuchElement
+	}
+	return a.element, true, nil
+}
+
+// DefaultIfEmpty emits the items of the source, or the given value if the source completes without emitting any
+func (b BaseObservable) DefaultIfEmpty(value interface{}) Observable {
+	return onAssembly((&ObservableDefaultIfEmpty{
+		source: b.Self(),
+		value:  value,
+	}).Init())
+}
+
+var _ Observable = (*ObservableDefaultIfEmpty)(nil)
+
+type ObservableDefaultIfEmpty struct {
+	BaseObservable
+	source ObservableSource
+	value  interface{}
+}
+
+func (o *ObservableDefaultIfEmpty) Init() *ObservableDefaultIfEmpty {
+	o.Self = func() ObservableSource {
+		return o
+	}
+	return o
+}
+
+func (o *ObservableDefaultIfEmpty) Type() reflect.Type {
+	return o.source.Type()
+}
+
+func (o *ObservableDefaultIfEmpty) subscribeActual(ctx context.Context, ob Observer) {
+	subscribe(ctx, o.source, &defaultIfEmptyObserver{
+		basicObserver: basicObserver{actual: ob},
+		value:         o.value,
+	})
+}
+
+var _ Observer = (*defaultIfEmptyObserver)(nil)
+
+type defaultIfEmptyObserver struct {
+	basicObserver
+	value    interface{}
+	nonEmpty bool
+}
+
+func (o *defaultIfEmptyObserver) Type() reflect.Type {
+	return o.actual.Type()
+}
+
+func (o *defaultIfEmptyObserver) OnNext(ctx context.Context, msg interface{}) {
+	if o.isTerminated() || isDone(ctx) {
+		return
+	}
+	o.nonEmpty = true
+	o.actual.OnNext(ctx, msg)
+}
+
+func (o *defaultIfEmptyObserver) OnComplete(ctx context.Context) {
+	if o.terminate() {
+		if !o.nonEmpty {
+			o.actual.OnNext(ctx, o.value)
+		}
+		o.actual.OnComplete(ctx)
+	}
+}
+
+// SwitchIfEmpty emits the items of the source, or subscribes to the other source if the source completes without
+// emitting any
+func (b BaseObservable) SwitchIfEmpty(other ObservableSource) Observable {
+	return onAssembly((&ObservableSwitchIfEmpty{
+		source: b.Self(),
+		other:  other,
+	}).Init())
+}
+
+var _ Observable = (*ObservableSwitchIfEmpty)(nil)
+
+type ObservableSwitchIfEmpty struct {
+	BaseObservable
+	source ObservableSource
+	other  ObservableSource
+}
+
+func (o *ObservableSwitchIfEmpty) Init() *ObservableSwitchIfEmpty {
+	o.Self = func() ObservableSource {
+		return o
+	}
+	return o
+}
+
+func (o *ObservableSwitchIfEmpty) Type() reflect.Type {
+	return o.source.Type()
+}
+
+func (o *ObservableSwitchIfEmpty) subscribeActual(ctx context.Context, ob Observer) {
+	subscribe(ctx, o.source, &switchIfEmptyObserver{
+		actual: ob,
+		other:  o.other,
+		empty:  true,
+	})
+}
+
+var _ Disposable = (*switchIfEmptyObserver)(nil)
+var _ Observer = (*switchIfEmptyObserver)(nil)
+
+type switchIfEmptyObserver struct {
+	actual     Observer
+	other      ObservableSource
+	upstream   DisposableRef
+	subscribed bool
+	empty      bool
+}
+
+func (o *switchIfEmptyObserver) Type() reflect.Type {
+	return o.actual.Type()
+}
+
+func (o *switchIfEmptyObserver) Dispose() {
+	o.upstream.Dispose()
+}
+
+func (o *switchIfEmptyObserver) IsDisposed() bool {
+	return o.upstream.IsDisposed()
+}
+
+// OnSubscribe is called once for the source, and once more for the other source if the source is empty
+func (o *switchIfEmptyObserver) OnSubscribe(disposable Disposable) {
+	if o.upstream.Set(disposable) && !o.subscribed {
+		o.subscribed = true
+		o.actual.OnSubscribe(o)
+	}
+}
+
+func (o *switchIfEmptyObserver) OnNext(ctx context.Context, msg interface{}) {
+	o.empty = false
+	o.actual.OnNext(ctx, msg)
+}
+
+func (o *switchIfEmptyObserver) OnError(ctx context.Context, err error) {
+	o.actual.OnError(ctx, err)
+}
+
+func (o *switchIfEmptyObserver) OnComplete(ctx context.Context) {
+	if o.empty {
+		o.empty = false
+		if !o.IsDisposed() {
+			subscribe(ctx, o.other, o)
+		}
+		return
+	}
+	o.actual.OnComplete(ctx)
+}
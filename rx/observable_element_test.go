@@ -0,0 +1,108 @@
+package rx
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBaseObservable_ElementAccess(t *testing.T) {
+	tests := []struct {
+		name       string
+		observable Observable
+		expect     interface{}
+		expectErr  error
+	}{
+		{name: "First", observable: Just(1, 2, 3).First(), expect: 1},
+		{name: "FirstEmpty", observable: Just().First(), expectErr: ErrNoSuchElement},
+		{name: "FirstOrDefault", observable: Just(1, 2).FirstOrDefault(0), expect: 1},
+		{name: "FirstOrDefaultEmpty", observable: Just().FirstOrDefault(0), expect: 0},
+		{name: "Last", observable: Just(1, 2, 3).Last(), expect: 3},
+		{name: "LastEmpty", observable: Just().Last(), expectErr: ErrNoSuchElement},
+		{name: "ElementAt", observable: Just(1, 2, 3).ElementAt(1), expect: 2},
+		{name: "ElementAtOutOfRange", observable: Just(1, 2, 3).ElementAt(3), expectErr: ErrNoSuchElement},
+		{name: "Single", observable: Just(1).Single(), expect: 1},
+		{name: "SingleEmpty", observable: Just().Single(), expectErr: ErrNoSuchElement},
+		{name: "SingleMoreThanOne", observable: Just(1, 2).Single(), expectErr: ErrSequenceContainsMoreThanOne},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ret, err := blockingSingle(tt.observable)
+			if tt.expectErr != nil {
+				assert.True(t, errors.Is(err, tt.expectErr), "expect %v but got %v", tt.expectErr, err)
+				return
+			} else if !assert.NoError(t, err, "should BlockingForEach without error") {
+				return
+			}
+			assert.Equal(t, tt.expect, ret)
+		})
+	}
+
+	t.Run("ElementAt_should_RejectNegativeIndexes", func(t *testing.T) {
+		_, err := blockingSingle(Just(1).ElementAt(-1))
+		assert.EqualError(t, err, "index should not be negative but got -1")
+	})
+
+	t.Run("First_should_DisposeTheUpstreamAfterTheFirstItem", func(t *testing.T) {
+		emitted := 0
+		ret, err := blockingSingle(Create(func(ctx context.Context, ob ObservableEmitter) {
+			for i := 1; i <= 10 && !ob.IsDisposed(); i++ {
+				emitted++
+				ob.OnNext(ctx, i)
+			}
+			ob.OnComplete(ctx)
+		}).First())
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, 1, ret)
+		assert.Equal(t, 1, emitted, "should stop the upstream after the first item")
+	})
+}
+
+func TestBaseObservable_DefaultIfEmpty(t *testing.T) {
+	t.Run("DefaultIfEmpty_should_EmitTheValueForAnEmptySource", func(t *testing.T) {
+		ret := new([]int)
+		err := Just().DefaultIfEmpty(42).BlockingToSlice(context.Background(), ret)
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, []int{42}, *ret)
+	})
+
+	t.Run("DefaultIfEmpty_should_EmitTheItemsOfANonEmptySource", func(t *testing.T) {
+		ret := new([]int)
+		err := Just(1, 2).DefaultIfEmpty(42).BlockingToSlice(context.Background(), ret)
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, []int{1, 2}, *ret)
+	})
+}
+
+func TestBaseObservable_SwitchIfEmpty(t *testing.T) {
+	t.Run("SwitchIfEmpty_should_SwitchToTheOtherSourceForAnEmptySource", func(t *testing.T) {
+		ret := new([]int)
+		err := Just().SwitchIfEmpty(Just(3, 4)).BlockingToSlice(context.Background(), ret)
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, []int{3, 4}, *ret)
+	})
+
+	t.Run("SwitchIfEmpty_should_EmitTheItemsOfANonEmptySource", func(t *testing.T) {
+		ret := new([]int)
+		err := Just(1).SwitchIfEmpty(Just(3, 4)).BlockingToSlice(context.Background(), ret)
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, []int{1}, *ret)
+	})
+
+	t.Run("SwitchIfEmpty_should_PropagateErrorsOfTheOtherSource", func(t *testing.T) {
+		err := Just().SwitchIfEmpty(errorObservable(emptyInterfaceType, errTest)).BlockingForEach(context.Background(), func(i int) {})
+		assert.Equal(t, errTest, err)
+	})
+}
@@ -0,0 +1,136 @@
+package rx
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+)
+
+// MapEntry is a key/value pair of a map emitted by FromMap
+type MapEntry struct {
+	Key   interface{}
+	Value interface{}
+}
+
+var mapEntryType = reflect.TypeOf((*MapEntry)(nil)).Elem()
+
+// FromSlice creates an Observable emitting the elements of the given slice or array in order, its Type is the
+// element type of the container
+func FromSlice(slice interface{}) Observable {
+	if slice == nil {
+		return errorObservable(emptyInterfaceType, fmt.Errorf("slice cannot be nil"))
+	}
+	v := reflect.ValueOf(slice)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return errorObservable(emptyInterfaceType, fmt.Errorf("slice should be a slice or an array but got %T", slice))
+	}
+	return newFromIterable(v.Type().Elem(), func() iterator {
+		i := 0
+		return func() (interface{}, bool) {
+			if i >= v.Len() {
+				return nil, false
+			}
+			i++
+			return v.Index(i - 1).Interface(), true
+		}
+	})
+}
+
+// FromMap creates an Observable emitting a MapEntry for each key of the given map, in no particular order
+func FromMap(m interface{}) Observable {
+	if m == nil {
+		return errorObservable(emptyInterfaceType, fmt.Errorf("map cannot be nil"))
+	}
+	v := reflect.ValueOf(m)
+	if v.Kind() != reflect.Map {
+		return errorObservable(emptyInterfaceType, fmt.Errorf("map should be a map but got %T", m))
+	}
+	return newFromIterable(mapEntryType, func() iterator {
+		it := v.MapRange()
+		return func() (interface{}, bool) {
+			if !it.Next() {
+				return nil, false
+			}
+			return MapEntry{Key: it.Key().Interface(), Value: it.Value().Interface()}, true
+		}
+	})
+}
+
+// Range creates an Observable emitting count sequential ints starting from start
+func Range(start, count int) Observable {
+	if count < 0 {
+		return errorObservable(intType, fmt.Errorf("count should not be negative but got %d", count))
+	}
+	return newFromIterable(intType, func() iterator {
+		i := 0
+		return func() (interface{}, bool) {
+			if i >= count {
+				return nil, false
+			}
+			i++
+			return start + i - 1, true
+		}
+	})
+}
+
+// Repeat creates an Observable emitting the given value n times, or until it's disposed if n is negative
+func Repeat(value interface{}, n int) Observable {
+	typ := emptyInterfaceType
+	if value != nil {
+		typ = reflect.TypeOf(value)
+	}
+	return newFromIterable(typ, func() iterator {
+		i := 0
+		return func() (interface{}, bool) {
+			if n >= 0 && i >= n {
+				return nil, false
+			}
+			i++
+			return value, true
+		}
+	})
+}
+
+// iterator returns the next item to emit, or false once there is no more item
+type iterator func() (interface{}, bool)
+
+var _ Observable = (*ObservableFromIterable)(nil)
+
+// ObservableFromIterable emits the items returned by a new iterator for each subscription
+type ObservableFromIterable struct {
+	BaseObservable
+	typ      reflect.Type
+	iterator func() iterator
+}
+
+func newFromIterable(typ reflect.Type, iterator func() iterator) Observable {
+	return onAssembly((&ObservableFromIterable{
+		typ:      typ,
+		iterator: iterator,
+	}).Init())
+}
+
+func (o *ObservableFromIterable) Init() *ObservableFromIterable {
+	o.Self = func() ObservableSource {
+		return o
+	}
+	return o
+}
+
+func (o *ObservableFromIterable) Type() reflect.Type {
+	return o.typ
+}
+
+func (o *ObservableFromIterable) subscribeActual(ctx context.Context, ob Observer) {
+	disposable := Disposables.Empty()
+	ob.OnSubscribe(disposable)
+	next := o.iterator()
+	for !disposable.IsDisposed() && !isDone(ctx) {
+		item, ok := next()
+		if !ok {
+			ob.OnComplete(ctx)
+			return
+		}
+		ob.OnNext(ctx, item)
+	}
+}
@@ -0,0 +1,102 @@
+package rx
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromSlice(t *testing.T) {
+	t.Run("FromSlice_should_EmitTheElementsInOrder", func(t *testing.T) {
+		ret := new([]string)
+		err := FromSlice([]string{"a", "b", "c"}).BlockingToSlice(context.Background(), ret)
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, []string{"a", "b", "c"}, *ret)
+	})
+
+	t.Run("FromSlice_should_AcceptArrays", func(t *testing.T) {
+		ret := new([]int)
+		err := FromSlice([2]int{1, 2}).BlockingToSlice(context.Background(), ret)
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, []int{1, 2}, *ret)
+	})
+
+	t.Run("FromSlice_should_ReportTheElementType", func(t *testing.T) {
+		assert.Equal(t, reflect.TypeOf(""), FromSlice([]string{}).Type())
+	})
+
+	t.Run("FromSlice_should_RejectNonSlices", func(t *testing.T) {
+		err := FromSlice(1).BlockingForEach(context.Background(), func(i int) {})
+		assert.EqualError(t, err, "slice should be a slice or an array but got int")
+	})
+
+	t.Run("FromSlice_should_StopOnceDisposed", func(t *testing.T) {
+		ret, err := FromSlice([]int{1, 2, 3}).BlockingFirst(context.Background())
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, 1, ret)
+	})
+}
+
+func TestFromMap(t *testing.T) {
+	t.Run("FromMap_should_EmitAnEntryPerKey", func(t *testing.T) {
+		var ret []MapEntry
+		err := FromMap(map[string]int{"a": 1, "b": 2}).BlockingToSlice(context.Background(), &ret)
+		if !assert.NoError(t, err) {
+			return
+		}
+		sort.Slice(ret, func(i, j int) bool {
+			return ret[i].Key.(string) < ret[j].Key.(string)
+		})
+		assert.Equal(t, []MapEntry{{Key: "a", Value: 1}, {Key: "b", Value: 2}}, ret)
+	})
+
+	t.Run("FromMap_should_ReportTheEntryType", func(t *testing.T) {
+		assert.Equal(t, reflect.TypeOf(MapEntry{}), FromMap(map[string]int{}).Type())
+	})
+}
+
+func TestRange(t *testing.T) {
+	t.Run("Range_should_EmitSequentialInts", func(t *testing.T) {
+		ret := new([]int)
+		err := Range(3, 4).BlockingToSlice(context.Background(), ret)
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, []int{3, 4, 5, 6}, *ret)
+		assert.Equal(t, reflect.TypeOf(0), Range(0, 1).Type())
+	})
+
+	t.Run("Range_should_RejectNegativeCounts", func(t *testing.T) {
+		err := Range(0, -1).BlockingForEach(context.Background(), func(i int) {})
+		assert.EqualError(t, err, "count should not be negative but got -1")
+	})
+}
+
+func TestRepeat(t *testing.T) {
+	t.Run("Repeat_should_EmitTheValueNTimes", func(t *testing.T) {
+		ret := new([]string)
+		err := Repeat("a", 3).BlockingToSlice(context.Background(), ret)
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, []string{"a", "a", "a"}, *ret)
+		assert.Equal(t, reflect.TypeOf(""), Repeat("a", 1).Type())
+	})
+
+	t.Run("Repeat_should_RepeatUntilDisposedForNegativeN", func(t *testing.T) {
+		ret, err := Repeat(1, -1).ElementAt(100).BlockingFirst(context.Background())
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, 1, ret)
+	})
+}
@@ -0,0 +1,389 @@
+package rx
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"www.github.com/secretworry/rx-go/rx/fun"
+)
+
+// GroupedObservable emits the items of a GroupBy source sharing the same key.
+// It is unicast: items are buffered until the first observer subscribes, and any later observer is rejected
+type GroupedObservable interface {
+	Observable
+	Key() interface{}
+}
+
+var groupedObservableType = reflect.TypeOf((*GroupedObservable)(nil)).Elem()
+
+type GroupByOption func(options *groupByOptions)
+
+type groupByOptions struct {
+	valueSelector interface{}
+	idleTimeout   time.Duration
+}
+
+// GroupByValueSelector maps every item before it is emitted by its group
+func GroupByValueSelector(valueSelector interface{}) GroupByOption {
+	return func(options *groupByOptions) {
+		options.valueSelector = valueSelector
+	}
+}
+
+// GroupByIdleTimeout completes and evicts a group once it has received no item for the given duration
+func GroupByIdleTimeout(idleTimeout time.Duration) GroupByOption {
+	return func(options *groupByOptions) {
+		options.idleTimeout = idleTimeout
+	}
+}
+
+func (b BaseObservable) GroupBy(keySelector interface{}, opts ...GroupByOption) Observable {
+	options := groupByOptions{}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	keyCaller, err := fun.CallerOf(keySelector)
+	if err != nil {
+		return errorObservable(groupedObservableType, err)
+	}
+	var valueCaller fun.Caller
+	if options.valueSelector != nil {
+		valueCaller, err = fun.CallerOf(options.valueSelector)
+		if err != nil {
+			return errorObservable(groupedObservableType, err)
+		}
+	}
+	return onAssembly((&ObservableGroupBy{
+		source:        b.Self(),
+		keySelector:   keyCaller,
+		valueSelector: valueCaller,
+		idleTimeout:   options.idleTimeout,
+	}).Init())
+}
+
+var _ Observable = (*ObservableGroupBy)(nil)
+
+type ObservableGroupBy struct {
+	BaseObservable
+	source        ObservableSource
+	keySelector   fun.Caller
+	valueSelector fun.Caller
+	idleTimeout   time.Duration
+}
+
+func (o *ObservableGroupBy) Init() *ObservableGroupBy {
+	o.Self = func() ObservableSource {
+		return o
+	}
+	return o
+}
+
+func (o *ObservableGroupBy) Type() reflect.Type {
+	return groupedObservableType
+}
+
+func (o *ObservableGroupBy) subscribeActual(ctx context.Context, ob Observer) {
+	subscribe(ctx, o.source, &groupByObserver{
+		parent: o,
+		actual: ob,
+		groups: make(map[interface{}]*groupedObservable),
+	})
+}
+
+var _ Disposable = (*groupByObserver)(nil)
+var _ Observer = (*groupByObserver)(nil)
+
+type groupByObserver struct {
+	parent    *ObservableGroupBy
+	actual    Observer
+	upstream  DisposableRef
+	cancelled int32
+
+	mu     sync.Mutex
+	groups map[interface{}]*groupedObservable
+	done   bool
+}
+
+func (o *groupByObserver) Type() reflect.Type {
+	return o.parent.keySelector.ReceiveType()
+}
+
+// Dispose stops emitting new groups; the upstream is only disposed once every emitted group has been disposed
+// or evicted
+func (o *groupByObserver) Dispose() {
+	if atomic.CompareAndSwapInt32(&o.cancelled, 0, 1) {
+		o.mu.Lock()
+		empty := len(o.groups) == 0
+		o.mu.Unlock()
+		if empty {
+			o.upstream.Dispose()
+		}
+	}
+}
+
+func (o *groupByObserver) IsDisposed() bool {
+	return atomic.LoadInt32(&o.cancelled) == 1
+}
+
+func (o *groupByObserver) OnSubscribe(disposable Disposable) {
+	if o.upstream.SetOnce(disposable) {
+		o.actual.OnSubscribe(o)
+	}
+}
+
+func (o *groupByObserver) OnNext(ctx context.Context, msg interface{}) {
+	if isDone(ctx) {
+		return
+	}
+	key, err := o.parent.keySelector.Call(ctx, msg)
+	if err != nil {
+		o.fail(ctx, err)
+		return
+	}
+	if !isComparable(key) {
+		o.fail(ctx, fmt.Errorf("group key should be comparable but got %T", key))
+		return
+	}
+	value := msg
+	if o.parent.valueSelector != nil {
+		value, err = o.parent.valueSelector.Call(ctx, msg)
+		if err != nil {
+			o.fail(ctx, err)
+			return
+		}
+	}
+	o.mu.Lock()
+	if o.done {
+		o.mu.Unlock()
+		return
+	}
+	group, ok := o.groups[key]
+	if !ok {
+		if o.IsDisposed() {
+			o.mu.Unlock()
+			return
+		}
+		group = newGroupedObservable(o, key)
+		o.groups[key] = group
+	}
+	// enqueue while holding the lock, so an eviction can never complete a group that just received an item
+	group.enqueue(value)
+	o.mu.Unlock()
+	if !ok {
+		o.actual.OnNext(ctx, group)
+	}
+	group.drain()
+}
+
+func (o *groupByObserver) OnError(ctx context.Context, err error) {
+	if groups, ok := o.terminate(); ok {
+		for _, group := range groups {
+			group.terminate(err)
+			group.drain()
+		}
+		o.actual.OnError(ctx, err)
+	}
+}
+
+func (o *groupByObserver) OnComplete(ctx context.Context) {
+	if groups, ok := o.terminate(); ok {
+		for _, group := range groups {
+			group.terminate(nil)
+			group.drain()
+		}
+		o.actual.OnComplete(ctx)
+	}
+}
+
+func (o *groupByObserver) fail(ctx context.Context, err error) {
+	o.upstream.Dispose()
+	o.OnError(ctx, err)
+}
+
+func (o *groupByObserver) terminate() ([]*groupedObservable, bool) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.done {
+		return nil, false
+	}
+	o.done = true
+	groups := make([]*groupedObservable, 0, len(o.groups))
+	for key, group := range o.groups {
+		groups = append(groups, group)
+		delete(o.groups, key)
+	}
+	return groups, true
+}
+
+// evict removes the given group, and completes it if it is still registered
+func (o *groupByObserver) evict(group *groupedObservable, complete bool) {
+	o.mu.Lock()
+	current, ok := o.groups[group.key]
+	if !ok || current != group {
+		o.mu.Unlock()
+		return
+	}
+	delete(o.groups, group.key)
+	if complete {
+		group.terminate(nil)
+	}
+	empty := len(o.groups) == 0
+	o.mu.Unlock()
+	if empty && o.IsDisposed() {
+		o.upstream.Dispose()
+	}
+}
+
+var _ GroupedObservable = (*groupedObservable)(nil)
+var _ Disposable = (*groupedObservable)(nil)
+
+type groupedObservable struct {
+	BaseObservable
+	parent    *groupByObserver
+	key       interface{}
+	cancelled int32
+
+	mu       sync.Mutex
+	ctx      context.Context
+	actual   Observer
+	queue    []interface{}
+	done     bool
+	err      error
+	emitting bool
+	timer    *time.Timer
+}
+
+func newGroupedObservable(parent *groupByObserver, key interface{}) *groupedObservable {
+	g := &groupedObservable{
+		parent: parent,
+		key:    key,
+	}
+	g.Self = func() ObservableSource {
+		return g
+	}
+	if idleTimeout := parent.parent.idleTimeout; idleTimeout > 0 {
+		g.mu.Lock()
+		defer g.mu.Unlock()
+		g.timer = time.AfterFunc(idleTimeout, onSchedule(func() {
+			parent.evict(g, true)
+			g.drain()
+		}))
+	}
+	return g
+}
+
+func (g *groupedObservable) Key() interface{} {
+	return g.key
+}
+
+func (g *groupedObservable) Type() reflect.Type {
+	if g.parent.parent.valueSelector != nil {
+		return g.parent.parent.valueSelector.ReturnType()
+	}
+	return g.parent.parent.source.Type()
+}
+
+func (g *groupedObservable) subscribeActual(ctx context.Context, ob Observer) {
+	g.mu.Lock()
+	if g.actual != nil {
+		g.mu.Unlock()
+		ob.OnSubscribe(Disposables.Empty())
+		ob.OnError(ctx, ErrGroupAlreadySubscribed)
+		return
+	}
+	g.ctx = ctx
+	g.actual = ob
+	g.mu.Unlock()
+	ob.OnSubscribe(g)
+	g.drain()
+}
+
+func (g *groupedObservable) Dispose() {
+	if atomic.CompareAndSwapInt32(&g.cancelled, 0, 1) {
+		g.mu.Lock()
+		g.queue = nil
+		if g.timer != nil {
+			g.timer.Stop()
+		}
+		g.mu.Unlock()
+		g.parent.evict(g, false)
+	}
+}
+
+func (g *groupedObservable) IsDisposed() bool {
+	return atomic.LoadInt32(&g.cancelled) == 1
+}
+
+func (g *groupedObservable) enqueue(value interface{}) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.done || g.IsDisposed() {
+		return
+	}
+	g.queue = append(g.queue, value)
+	if g.timer != nil {
+		g.timer.Reset(g.parent.parent.idleTimeout)
+	}
+}
+
+func (g *groupedObservable) terminate(err error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.done {
+		return
+	}
+	g.done = true
+	g.err = err
+	if g.timer != nil {
+		g.timer.Stop()
+	}
+}
+
+// drain delivers the buffered signals to the observer; only one goroutine drains at a time and the observer is
+// never called while holding the lock
+func (g *groupedObservable) drain() {
+	g.mu.Lock()
+	if g.emitting {
+		g.mu.Unlock()
+		return
+	}
+	g.emitting = true
+	for {
+		if g.actual == nil || g.IsDisposed() {
+			g.emitting = false
+			g.mu.Unlock()
+			return
+		}
+		ctx, actual := g.ctx, g.actual
+		items, done, err := g.queue, g.done, g.err
+		g.queue = nil
+		g.mu.Unlock()
+		for _, item := range items {
+			if g.IsDisposed() || isDone(ctx) {
+				break
+			}
+			actual.OnNext(ctx, item)
+		}
+		if done {
+			// leave emitting set, so the terminated group is never drained again
+			if !g.IsDisposed() && !isDone(ctx) {
+				if err != nil {
+					actual.OnError(ctx, err)
+				} else {
+					actual.OnComplete(ctx)
+				}
+			}
+			return
+		}
+		g.mu.Lock()
+		if len(g.queue) == 0 && !g.done {
+			g.emitting = false
+			g.mu.Unlock()
+			return
+		}
+	}
+}
@@ -0,0 +1,152 @@
+package rx
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBaseObservable_GroupBy(t *testing.T) {
+	parity := func(i int) int { return i % 2 }
+
+	t.Run("GroupBy_should_EmitItemsToTheGroupOfTheirKey", func(t *testing.T) {
+		ctx := context.Background()
+		groups := map[interface{}]*testObserver{}
+		err := Just(1, 2, 3, 4, 5).GroupBy(parity).BlockingForEach(ctx, func(g GroupedObservable) {
+			ob := newTestObserver()
+			groups[g.Key()] = ob
+			g.Subscribe(ctx, ob)
+		})
+		if !assert.NoError(t, err, "should BlockingForEach without error") {
+			return
+		}
+		if !assert.Len(t, groups, 2, "should emit a group per key") {
+			return
+		}
+		assert.Equal(t, []interface{}{1, 3, 5}, groups[1].Items())
+		assert.Equal(t, []interface{}{2, 4}, groups[0].Items())
+		assert.True(t, groups[0].Completed(), "should complete every group")
+		assert.True(t, groups[1].Completed(), "should complete every group")
+	})
+
+	t.Run("GroupBy_should_BufferItemsUntilSubscribed", func(t *testing.T) {
+		ctx := context.Background()
+		var groups []GroupedObservable
+		err := Just(1, 2, 3).GroupBy(parity).BlockingForEach(ctx, func(g GroupedObservable) {
+			groups = append(groups, g)
+		})
+		if !assert.NoError(t, err, "should BlockingForEach without error") {
+			return
+		}
+		if !assert.Len(t, groups, 2) {
+			return
+		}
+		ob := newTestObserver()
+		groups[0].Subscribe(ctx, ob)
+		assert.Equal(t, []interface{}{1, 3}, ob.Items())
+		assert.True(t, ob.Completed(), "should replay the completion")
+	})
+
+	t.Run("GroupBy_should_RejectTheSecondObserverOfAGroup", func(t *testing.T) {
+		ctx := context.Background()
+		var group GroupedObservable
+		err := Just(1).GroupBy(parity).BlockingForEach(ctx, func(g GroupedObservable) {
+			group = g
+		})
+		if !assert.NoError(t, err) {
+			return
+		}
+		group.Subscribe(ctx, newTestObserver())
+		ob := newTestObserver()
+		group.Subscribe(ctx, ob)
+		assert.Equal(t, ErrGroupAlreadySubscribed, ob.Err())
+	})
+
+	t.Run("GroupBy_should_ApplyTheValueSelector", func(t *testing.T) {
+		ctx := context.Background()
+		ob := newTestObserver()
+		err := Just(1, 2, 3).GroupBy(func(i int) string {
+			return "all"
+		}, GroupByValueSelector(func(i int) string {
+			return fmt.Sprint(i * 10)
+		})).BlockingForEach(ctx, func(g GroupedObservable) {
+			g.Subscribe(ctx, ob)
+		})
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, []interface{}{"10", "20", "30"}, ob.Items())
+	})
+
+	t.Run("GroupBy_should_StartANewGroupAfterTheGroupIsDisposed", func(t *testing.T) {
+		ctx := context.Background()
+		var observers []*testObserver
+		err := Create(func(ctx context.Context, ob ObservableEmitter) {
+			ob.OnNext(ctx, 1)
+			observers[0].Dispose()
+			ob.OnNext(ctx, 3)
+			ob.OnComplete(ctx)
+		}).GroupBy(parity).BlockingForEach(ctx, func(g GroupedObservable) {
+			ob := newTestObserver()
+			observers = append(observers, ob)
+			g.Subscribe(ctx, ob)
+		})
+		if !assert.NoError(t, err) {
+			return
+		}
+		if !assert.Len(t, observers, 2, "should create a new group for the evicted key") {
+			return
+		}
+		assert.Equal(t, []interface{}{1}, observers[0].Items())
+		assert.Equal(t, []interface{}{3}, observers[1].Items())
+	})
+
+	t.Run("GroupBy_should_EvictIdleGroups", func(t *testing.T) {
+		ctx := context.Background()
+		var observers []*testObserver
+		err := Create(func(ctx context.Context, ob ObservableEmitter) {
+			ob.OnNext(ctx, 1)
+			<-observers[0].done
+			ob.OnNext(ctx, 3)
+			ob.OnComplete(ctx)
+		}).GroupBy(parity, GroupByIdleTimeout(10*time.Millisecond)).BlockingForEach(ctx, func(g GroupedObservable) {
+			ob := newTestObserver()
+			observers = append(observers, ob)
+			g.Subscribe(ctx, ob)
+		})
+		if !assert.NoError(t, err) {
+			return
+		}
+		if !assert.Len(t, observers, 2, "should create a new group for the evicted key") {
+			return
+		}
+		assert.True(t, observers[0].Completed(), "should complete the idle group")
+		assert.Equal(t, []interface{}{1}, observers[0].Items())
+		assert.Equal(t, []interface{}{3}, observers[1].Items())
+	})
+
+	t.Run("GroupBy_should_PropagateKeySelectorErrors", func(t *testing.T) {
+		ctx := context.Background()
+		err := Just(1).GroupBy(func(i int) (int, error) {
+			return 0, errTest
+		}).BlockingForEach(ctx, func(g GroupedObservable) {})
+		assert.Equal(t, errTest, err)
+	})
+
+	t.Run("GroupBy_should_FailOnKeysHoldingNonComparableValues", func(t *testing.T) {
+		ctx := context.Background()
+		err := Just(1).GroupBy(func(i int) [1]interface{} {
+			return [1]interface{}{[]int{i}}
+		}).BlockingForEach(ctx, func(g GroupedObservable) {})
+		assert.EqualError(t, err, "group key should be comparable but got [1]interface {}")
+	})
+
+	t.Run("GroupBy_should_RejectInvalidKeySelectors", func(t *testing.T) {
+		ctx := context.Background()
+		err := Just(1).GroupBy(1).BlockingForEach(ctx, func(g GroupedObservable) {})
+		assert.EqualError(t, err, "call should be a function")
+	})
+}
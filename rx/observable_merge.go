@@ -0,0 +1,137 @@
+package rx
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"sync/atomic"
+)
+
+// MergeDelayError subscribes to all the sources at once and emits their items as they come. An error of a source
+// does not stop the others, every error is signaled once all the sources terminate, as a *CompositeError if there
+// are more than one
+func MergeDelayError(sources ...ObservableSource) Observable {
+	return onAssembly((&ObservableMergeDelayError{
+		sources: sources,
+	}).Init())
+}
+
+// commonSourceType returns the Type shared by all the sources, or interface{} if they differ
+func commonSourceType(sources []ObservableSource) reflect.Type {
+	var typ reflect.Type
+	for i, source := range sources {
+		t := source.Type()
+		if i > 0 && t != typ {
+			return emptyInterfaceType
+		}
+		typ = t
+	}
+	if typ == nil {
+		return emptyInterfaceType
+	}
+	return typ
+}
+
+var _ Observable = (*ObservableMergeDelayError)(nil)
+
+type ObservableMergeDelayError struct {
+	BaseObservable
+	sources []ObservableSource
+}
+
+func (o *ObservableMergeDelayError) Init() *ObservableMergeDelayError {
+	o.Self = func() ObservableSource {
+		return o
+	}
+	return o
+}
+
+func (o *ObservableMergeDelayError) Type() reflect.Type {
+	return commonSourceType(o.sources)
+}
+
+func (o *ObservableMergeDelayError) subscribeActual(ctx context.Context, ob Observer) {
+	m := &mergeDelayErrorCoordinator{
+		actual:      NewSerializedObserver(ob),
+		disposables: NewCompositeDisposable(),
+		remaining:   int32(len(o.sources)),
+	}
+	ob.OnSubscribe(m.disposables)
+	if len(o.sources) == 0 {
+		m.actual.OnComplete(ctx)
+		return
+	}
+	for _, source := range o.sources {
+		if m.disposables.IsDisposed() {
+			return
+		}
+		subscribe(ctx, source, &mergeInnerObserver{parent: m})
+	}
+}
+
+// mergeDelayErrorCoordinator collects the errors of the sources, and terminates once all of them terminate
+type mergeDelayErrorCoordinator struct {
+	actual      *SerializedObserver
+	disposables *CompositeDisposable
+	remaining   int32
+
+	mu   sync.Mutex
+	errs []error
+}
+
+func (m *mergeDelayErrorCoordinator) innerDone(ctx context.Context, err error) {
+	if err != nil {
+		m.mu.Lock()
+		m.errs = append(m.errs, err)
+		m.mu.Unlock()
+	}
+	if atomic.AddInt32(&m.remaining, -1) > 0 {
+		return
+	}
+	m.mu.Lock()
+	err = compositeErrorOf(m.errs)
+	m.mu.Unlock()
+	if err != nil {
+		m.actual.OnError(ctx, err)
+	} else {
+		m.actual.OnComplete(ctx)
+	}
+}
+
+var _ Observer = (*mergeInnerObserver)(nil)
+
+type mergeInnerObserver struct {
+	parent   *mergeDelayErrorCoordinator
+	upstream Disposable
+}
+
+func (o *mergeInnerObserver) Type() reflect.Type {
+	return o.parent.actual.Type()
+}
+
+func (o *mergeInnerObserver) OnSubscribe(disposable Disposable) {
+	if o.parent.disposables.Add(disposable) {
+		o.upstream = disposable
+	}
+}
+
+func (o *mergeInnerObserver) OnNext(ctx context.Context, msg interface{}) {
+	o.parent.actual.OnNext(ctx, msg)
+}
+
+func (o *mergeInnerObserver) OnError(ctx context.Context, err error) {
+	o.release()
+	o.parent.innerDone(ctx, err)
+}
+
+func (o *mergeInnerObserver) OnComplete(ctx context.Context) {
+	o.release()
+	o.parent.innerDone(ctx, nil)
+}
+
+// release forgets the upstream of the terminated source, so the composite doesn't grow with the finished ones
+func (o *mergeInnerObserver) release() {
+	if o.upstream != nil {
+		o.parent.disposables.Delete(o.upstream)
+	}
+}
@@ -0,0 +1,68 @@
+package rx
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// asyncRange emits 0 to n-1 from a goroutine of its own
+func asyncRange(n int) Observable {
+	return Create(func(ctx context.Context, emitter ObservableEmitter) {
+		go func() {
+			for i := 0; i < n && !emitter.IsDisposed(); i++ {
+				emitter.OnNext(ctx, i)
+			}
+			emitter.OnComplete(ctx)
+		}()
+	})
+}
+
+func TestMergeDelayError(t *testing.T) {
+	other := errors.New("other")
+
+	t.Run("MergeDelayError_should_EmitTheItemsOfAllTheSources", func(t *testing.T) {
+		var items []int
+		err := MergeDelayError(Just(1, 2), Just(3), Just(4, 5)).BlockingToSlice(context.Background(), &items)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []int{1, 2, 3, 4, 5}, items)
+	})
+
+	t.Run("MergeDelayError_should_ReportEveryErrorOnceAllTheSourcesTerminate", func(t *testing.T) {
+		ob := newTestObserver()
+		MergeDelayError(errorObservable(emptyInterfaceType, errTest), Just(1, 2), errorObservable(emptyInterfaceType, other), Just(3)).Subscribe(context.Background(), ob)
+		<-ob.done
+		assert.ElementsMatch(t, []interface{}{1, 2, 3}, ob.Items())
+		var composite *CompositeError
+		if assert.True(t, errors.As(ob.Err(), &composite)) {
+			assert.Equal(t, []error{errTest, other}, composite.Errors())
+		}
+		assert.True(t, errors.Is(ob.Err(), other))
+	})
+
+	t.Run("MergeDelayError_should_NotWrapASingleError", func(t *testing.T) {
+		ob := newTestObserver()
+		MergeDelayError(Just(1), errorObservable(emptyInterfaceType, errTest)).Subscribe(context.Background(), ob)
+		<-ob.done
+		assert.Equal(t, errTest, ob.Err())
+		assert.Equal(t, []interface{}{1}, ob.Items())
+	})
+
+	t.Run("MergeDelayError_should_CompleteWithoutSources", func(t *testing.T) {
+		ob := newTestObserver()
+		MergeDelayError().Subscribe(context.Background(), ob)
+		assert.True(t, ob.Completed())
+	})
+
+	t.Run("MergeDelayError_should_MergeConcurrentSources", func(t *testing.T) {
+		ob := &exclusiveObserver{testObserver: newTestObserver()}
+		MergeDelayError(asyncRange(100), asyncRange(100)).Subscribe(context.Background(), ob)
+		<-ob.done
+		assert.Equal(t, int32(0), atomic.LoadInt32(&ob.concurrent), "should never call the observer concurrently")
+		assert.Len(t, ob.Items(), 200)
+		assert.True(t, ob.Completed())
+	})
+}
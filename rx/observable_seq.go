@@ -0,0 +1,89 @@
+package rx
+
+import (
+	"context"
+	"iter"
+	"reflect"
+)
+
+// seqPrefetch is the number of items buffered ahead of the loop ranging over Seq
+const seqPrefetch = 16
+
+// FromSeq creates an Observable emitting the values of the given iterator, its Type is V
+func FromSeq[V any](seq iter.Seq[V]) Observable {
+	return onAssembly((&ObservableFromSeq{
+		typ: reflect.TypeOf((*V)(nil)).Elem(),
+		seq: func(yield func(interface{}) bool) {
+			for v := range seq {
+				if !yield(v) {
+					return
+				}
+			}
+		},
+	}).Init())
+}
+
+// FromSeq2 creates an Observable emitting a MapEntry for each key/value pair of the given iterator
+func FromSeq2[K, V any](seq iter.Seq2[K, V]) Observable {
+	return onAssembly((&ObservableFromSeq{
+		typ: mapEntryType,
+		seq: func(yield func(interface{}) bool) {
+			for k, v := range seq {
+				if !yield(MapEntry{Key: k, Value: v}) {
+					return
+				}
+			}
+		},
+	}).Init())
+}
+
+var _ Observable = (*ObservableFromSeq)(nil)
+
+type ObservableFromSeq struct {
+	BaseObservable
+	typ reflect.Type
+	seq iter.Seq[interface{}]
+}
+
+func (o *ObservableFromSeq) Init() *ObservableFromSeq {
+	o.Self = func() ObservableSource {
+		return o
+	}
+	return o
+}
+
+func (o *ObservableFromSeq) Type() reflect.Type {
+	return o.typ
+}
+
+func (o *ObservableFromSeq) subscribeActual(ctx context.Context, ob Observer) {
+	disposable := Disposables.Empty()
+	ob.OnSubscribe(disposable)
+	for item := range o.seq {
+		if disposable.IsDisposed() || isDone(ctx) {
+			return
+		}
+		ob.OnNext(ctx, item)
+	}
+	if !disposable.IsDisposed() && !isDone(ctx) {
+		ob.OnComplete(ctx)
+	}
+}
+
+// Seq returns an iterator over the items of the source, which pairs each item with a nil error, and ends with the
+// error terminating the source if any. The source is subscribed on a new goroutine when the iteration starts, and
+// disposed if the loop breaks early. A few items are buffered ahead of the loop
+func (b BaseObservable) Seq(ctx context.Context) iter.Seq2[interface{}, error] {
+	return func(yield func(interface{}, error) bool) {
+		it := b.BlockingIterable(ctx, seqPrefetch)
+		defer it.Close()
+		for it.Next() {
+			if !yield(it.Value(), nil) {
+				return
+			}
+		}
+		if err := it.Err(); err != nil {
+			yield(nil, err)
+		}
+	}
+}
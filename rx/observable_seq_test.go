@@ -0,0 +1,130 @@
+package rx
+
+import (
+	"context"
+	"maps"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromSeq(t *testing.T) {
+	t.Run("FromSeq_should_EmitTheValuesOfTheIterator", func(t *testing.T) {
+		observable := FromSeq(slices.Values([]string{"a", "b"}))
+		assert.Equal(t, reflect.TypeOf(""), observable.Type())
+		ret := new([]string)
+		if !assert.NoError(t, observable.BlockingToSlice(context.Background(), ret)) {
+			return
+		}
+		assert.Equal(t, []string{"a", "b"}, *ret)
+	})
+
+	t.Run("FromSeq_should_StopTheIteratorOnceDisposed", func(t *testing.T) {
+		pulled := 0
+		seq := func(yield func(int) bool) {
+			for i := 0; ; i++ {
+				pulled++
+				if !yield(i) {
+					return
+				}
+			}
+		}
+		ret, err := FromSeq(seq).BlockingFirst(context.Background())
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, 0, ret)
+		assert.Equal(t, 2, pulled, "should stop pulling right after disposed")
+	})
+
+	t.Run("FromSeq2_should_EmitAnEntryPerPair", func(t *testing.T) {
+		var ret []MapEntry
+		err := FromSeq2(slices.All([]string{"a", "b"})).BlockingToSlice(context.Background(), &ret)
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, []MapEntry{{Key: 0, Value: "a"}, {Key: 1, Value: "b"}}, ret)
+	})
+
+	t.Run("FromSeq2_should_AcceptMapIterators", func(t *testing.T) {
+		ret, err := FromSeq2(maps.All(map[string]int{"a": 1})).BlockingSingle(context.Background())
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, MapEntry{Key: "a", Value: 1}, ret)
+	})
+}
+
+func TestBaseObservable_Seq(t *testing.T) {
+	t.Run("Seq_should_RangeOverTheItems", func(t *testing.T) {
+		var items []interface{}
+		for v, err := range Just(1, 2, 3).Seq(context.Background()) {
+			if !assert.NoError(t, err) {
+				return
+			}
+			items = append(items, v)
+		}
+		assert.Equal(t, []interface{}{1, 2, 3}, items)
+	})
+
+	t.Run("Seq_should_YieldTheErrorLast", func(t *testing.T) {
+		var errs []error
+		for _, err := range errorObservable(emptyInterfaceType, errTest).Seq(context.Background()) {
+			errs = append(errs, err)
+		}
+		assert.Equal(t, []error{errTest}, errs)
+	})
+
+	t.Run("Seq_should_DisposeTheSourceWhenTheLoopBreaks", func(t *testing.T) {
+		disposed := make(chan struct{})
+		source := Create(func(ctx context.Context, ob ObservableEmitter) {
+			ob.SetDisposable(Disposables.FromFunc(func() {
+				close(disposed)
+			}))
+			for i := 0; !ob.IsDisposed(); i++ {
+				ob.OnNext(ctx, i)
+			}
+		})
+		for v := range source.Seq(context.Background()) {
+			if v == 2 {
+				break
+			}
+		}
+		<-disposed
+	})
+
+	t.Run("Seq_should_NotSubscribeUntilRanged", func(t *testing.T) {
+		subscribed := false
+		_ = Create(func(ctx context.Context, ob ObservableEmitter) {
+			subscribed = true
+		}).Seq(context.Background())
+		assert.False(t, subscribed)
+	})
+
+	t.Run("Seq_should_YieldACancelledErrorOnceTheContextIsDone", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		source := Create(func(ctx context.Context, ob ObservableEmitter) {
+			ob.OnNext(ctx, 1)
+			<-ctx.Done()
+		})
+		var items []interface{}
+		var err error
+		for v, e := range source.Seq(ctx) {
+			if e != nil {
+				err = e
+				break
+			}
+			items = append(items, v)
+			cancel()
+		}
+		assert.Equal(t, []interface{}{1}, items)
+		var cancelled *CancelledError
+		if assert.ErrorAs(t, err, &cancelled) {
+			assert.Equal(t, 1, cancelled.Consumed)
+			assert.ErrorIs(t, err, context.Canceled)
+		}
+	})
+}
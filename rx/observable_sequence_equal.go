@@ -0,0 +1,166 @@
+package rx
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"sync/atomic"
+)
+
+// SequenceEqual emits whether the source and the other ObservableSource emit equal items in the same order.
+// Items of comparable types are compared with ==, and the others with reflect.DeepEqual.
+// It emits false and disposes both sources as soon as they differ
+func (b BaseObservable) SequenceEqual(other ObservableSource) Observable {
+	return onAssembly((&ObservableSequenceEqual{
+		first:  b.Self(),
+		second: other,
+	}).Init())
+}
+
+var _ Observable = (*ObservableSequenceEqual)(nil)
+
+type ObservableSequenceEqual struct {
+	BaseObservable
+	first  ObservableSource
+	second ObservableSource
+}
+
+func (o *ObservableSequenceEqual) Init() *ObservableSequenceEqual {
+	o.Self = func() ObservableSource {
+		return o
+	}
+	return o
+}
+
+func (o *ObservableSequenceEqual) Type() reflect.Type {
+	return boolType
+}
+
+func (o *ObservableSequenceEqual) subscribeActual(ctx context.Context, ob Observer) {
+	c := &sequenceEqualCoordinator{actual: ob}
+	c.observers[0] = &sequenceEqualObserver{parent: c, index: 0}
+	c.observers[1] = &sequenceEqualObserver{parent: c, index: 1}
+	ob.OnSubscribe(c)
+	subscribe(ctx, o.first, c.observers[0])
+	subscribe(ctx, o.second, c.observers[1])
+}
+
+var _ Disposable = (*sequenceEqualCoordinator)(nil)
+
+type sequenceEqualCoordinator struct {
+	actual    Observer
+	observers [2]*sequenceEqualObserver
+	cancelled int32
+
+	mu         sync.Mutex
+	queues     [2][]interface{}
+	done       [2]bool
+	terminated bool
+}
+
+func (c *sequenceEqualCoordinator) Dispose() {
+	if atomic.CompareAndSwapInt32(&c.cancelled, 0, 1) {
+		c.observers[0].Dispose()
+		c.observers[1].Dispose()
+	}
+}
+
+func (c *sequenceEqualCoordinator) IsDisposed() bool {
+	return atomic.LoadInt32(&c.cancelled) == 1
+}
+
+// signal records a signal from the source of the given index, and emits the result once it's known
+func (c *sequenceEqualCoordinator) signal(ctx context.Context, index int, msg interface{}, done bool) {
+	c.mu.Lock()
+	if c.terminated {
+		c.mu.Unlock()
+		return
+	}
+	if done {
+		c.done[index] = true
+	} else {
+		c.queues[index] = append(c.queues[index], msg)
+	}
+	equal, known := c.compare()
+	if known {
+		c.terminated = true
+		c.queues[0], c.queues[1] = nil, nil
+	}
+	c.mu.Unlock()
+	if known {
+		c.Dispose()
+		if !isDone(ctx) {
+			c.actual.OnNext(ctx, equal)
+			c.actual.OnComplete(ctx)
+		}
+	}
+}
+
+func (c *sequenceEqualCoordinator) compare() (equal bool, known bool) {
+	for len(c.queues[0]) > 0 && len(c.queues[1]) > 0 {
+		a, b := c.queues[0][0], c.queues[1][0]
+		c.queues[0], c.queues[1] = c.queues[0][1:], c.queues[1][1:]
+		if !equalValues(a, b) {
+			return false, true
+		}
+	}
+	empty0, empty1 := len(c.queues[0]) == 0, len(c.queues[1]) == 0
+	switch {
+	case c.done[0] && c.done[1]:
+		return empty0 && empty1, true
+	case c.done[0] && empty0 && !empty1, c.done[1] && empty1 && !empty0:
+		return false, true
+	default:
+		return false, false
+	}
+}
+
+func (c *sequenceEqualCoordinator) fail(ctx context.Context, err error) {
+	c.mu.Lock()
+	if c.terminated {
+		c.mu.Unlock()
+		return
+	}
+	c.terminated = true
+	c.queues[0], c.queues[1] = nil, nil
+	c.mu.Unlock()
+	c.Dispose()
+	c.actual.OnError(ctx, err)
+}
+
+var _ Observer = (*sequenceEqualObserver)(nil)
+var _ Disposable = (*sequenceEqualObserver)(nil)
+
+type sequenceEqualObserver struct {
+	parent   *sequenceEqualCoordinator
+	index    int
+	upstream DisposableRef
+}
+
+func (o *sequenceEqualObserver) Type() reflect.Type {
+	return emptyInterfaceType
+}
+
+func (o *sequenceEqualObserver) Dispose() {
+	o.upstream.Dispose()
+}
+
+func (o *sequenceEqualObserver) IsDisposed() bool {
+	return o.upstream.IsDisposed()
+}
+
+func (o *sequenceEqualObserver) OnSubscribe(disposable Disposable) {
+	o.upstream.SetOnce(disposable)
+}
+
+func (o *sequenceEqualObserver) OnNext(ctx context.Context, msg interface{}) {
+	o.parent.signal(ctx, o.index, msg, false)
+}
+
+func (o *sequenceEqualObserver) OnError(ctx context.Context, err error) {
+	o.parent.fail(ctx, err)
+}
+
+func (o *sequenceEqualObserver) OnComplete(ctx context.Context) {
+	o.parent.signal(ctx, o.index, nil, true)
+}
@@ -0,0 +1,222 @@
+package rx
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"reflect"
+	"sync/atomic"
+
+	"www.github.com/secretworry/rx-go/rx/fun"
+)
+
+var observableSourceType = reflect.TypeOf((*ObservableSource)(nil)).Elem()
+
+// Using creates a resource for each subscription, and emits the signals of the source built from it.
+// resourceFactory is shaped like func([ctx context.Context]) R [error], sourceFactory like
+// func([ctx context.Context,] resource R) ObservableSource [error], and disposer like
+// func([ctx context.Context,] resource R) [error].
+// The resource is released exactly once when the source terminates or the subscription is disposed. If disposer is
+// nil, an io.Closer resource is closed, and any other resource is left as is.
+// A panic of any of the three functions is signaled as a *PanicError, after releasing the resource if it was created.
+// If eager is true, the resource is released before the terminal signal is emitted, and an error releasing it is
+// emitted along; otherwise it's released afterwards, and the error goes to the undeliverable error handler.
+// The Type of the returned Observable is interface{} since the sources are only known on subscription
+func Using(resourceFactory, sourceFactory, disposer interface{}, eager bool) Observable {
+	resourceSupplier, err := fun.SupplierOf(resourceFactory)
+	if err != nil {
+		return errorObservable(emptyInterfaceType, err)
+	}
+	sourceCaller, err := fun.CallerOf(sourceFactory)
+	if err != nil {
+		return errorObservable(emptyInterfaceType, err)
+	}
+	if !sourceCaller.ReturnType().Implements(observableSourceType) {
+		return errorObservable(emptyInterfaceType, fmt.Errorf("sourceFactory should return an ObservableSource but got %s", sourceCaller.ReturnType()))
+	}
+	var disposerRunner fun.Runner
+	if disposer != nil {
+		disposerRunner, err = fun.RunnerOf(disposer)
+		if err != nil {
+			return errorObservable(emptyInterfaceType, err)
+		}
+	}
+	return onAssembly((&ObservableUsing{
+		resourceSupplier: resourceSupplier,
+		sourceCaller:     sourceCaller,
+		disposerRunner:   disposerRunner,
+		eager:            eager,
+	}).Init())
+}
+
+var _ Observable = (*ObservableUsing)(nil)
+
+type ObservableUsing struct {
+	BaseObservable
+	resourceSupplier fun.Supplier
+	sourceCaller     fun.Caller
+	disposerRunner   fun.Runner
+	eager            bool
+}
+
+func (o *ObservableUsing) Init() *ObservableUsing {
+	o.Self = func() ObservableSource {
+		return o
+	}
+	return o
+}
+
+func (o *ObservableUsing) Type() reflect.Type {
+	return emptyInterfaceType
+}
+
+func (o *ObservableUsing) subscribeActual(ctx context.Context, ob Observer) {
+	resource, err := recoverCall(func() (interface{}, error) {
+		return o.resourceSupplier.Call(ctx)
+	})
+	if err != nil {
+		ob.OnSubscribe(Disposables.Empty())
+		ob.OnError(ctx, err)
+		return
+	}
+	release := o.releaserOf(ctx, resource)
+	source, err := recoverCall(func() (interface{}, error) {
+		return o.sourceCaller.Call(ctx, resource)
+	})
+	if err == nil && isNil(source) {
+		err = fmt.Errorf("sourceFactory returned a nil ObservableSource")
+	}
+	if err != nil {
+		ob.OnSubscribe(Disposables.Empty())
+		ob.OnError(ctx, compositeErrorOf([]error{err, release.DisposeErr()}))
+		return
+	}
+	subscribe(ctx, source.(ObservableSource), &usingObserver{
+		actual:  ob,
+		release: release,
+		eager:   o.eager,
+	})
+}
+
+// releaserOf returns an ErrorDisposable releasing the given resource once, a panic releasing it is returned as a
+// *PanicError
+func (o *ObservableUsing) releaserOf(ctx context.Context, resource interface{}) ErrorDisposable {
+	release := func() error {
+		return nil
+	}
+	if o.disposerRunner != nil {
+		// the resource is released even if the subscription is released because ctx is done
+		ctx = context.WithoutCancel(ctx)
+		release = func() error {
+			return o.disposerRunner.Run(ctx, resource)
+		}
+	} else if closer, ok := resource.(io.Closer); ok {
+		release = closer.Close
+	}
+	return Disposables.FromCloser(closerFunc(func() error {
+		_, err := recoverCall(func() (interface{}, error) {
+			return nil, release()
+		})
+		return err
+	}))
+}
+
+// recoverCall calls f, and returns the *PanicError of its panic if it panics
+func recoverCall(f func() (interface{}, error)) (v interface{}, err error) {
+	defer func() {
+		if e := recover(); e != nil {
+			err = toError(e)
+		}
+	}()
+	return f()
+}
+
+// isNil tells whether v is nil, or a nil pointer, map, slice, func, chan or interface held by an interface
+func isNil(v interface{}) bool {
+	if v == nil {
+		return true
+	}
+	switch rv := reflect.ValueOf(v); rv.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan, reflect.Interface:
+		return rv.IsNil()
+	default:
+		return false
+	}
+}
+
+// closerFunc adapts a func to io.Closer
+type closerFunc func() error
+
+func (f closerFunc) Close() error {
+	return f()
+}
+
+var _ Disposable = (*usingObserver)(nil)
+var _ Observer = (*usingObserver)(nil)
+
+type usingObserver struct {
+	actual   Observer
+	upstream DisposableRef
+	release  ErrorDisposable
+	eager    bool
+	done     int32
+}
+
+func (o *usingObserver) Type() reflect.Type {
+	return o.actual.Type()
+}
+
+func (o *usingObserver) Dispose() {
+	if o.eager {
+		o.release.Dispose()
+		o.upstream.Dispose()
+	} else {
+		o.upstream.Dispose()
+		o.release.Dispose()
+	}
+}
+
+func (o *usingObserver) IsDisposed() bool {
+	return o.upstream.IsDisposed()
+}
+
+func (o *usingObserver) OnSubscribe(disposable Disposable) {
+	if o.upstream.SetOnce(disposable) {
+		o.actual.OnSubscribe(o)
+	}
+}
+
+func (o *usingObserver) OnNext(ctx context.Context, msg interface{}) {
+	if atomic.LoadInt32(&o.done) == 0 {
+		o.actual.OnNext(ctx, msg)
+	}
+}
+
+func (o *usingObserver) OnError(ctx context.Context, err error) {
+	if !atomic.CompareAndSwapInt32(&o.done, 0, 1) {
+		return
+	}
+	if o.eager {
+		err = compositeErrorOf([]error{err, o.release.DisposeErr()})
+		o.actual.OnError(ctx, err)
+		return
+	}
+	o.actual.OnError(ctx, err)
+	o.release.Dispose()
+}
+
+func (o *usingObserver) OnComplete(ctx context.Context) {
+	if !atomic.CompareAndSwapInt32(&o.done, 0, 1) {
+		return
+	}
+	if o.eager {
+		if err := o.release.DisposeErr(); err != nil {
+			o.actual.OnError(ctx, err)
+			return
+		}
+		o.actual.OnComplete(ctx)
+		return
+	}
+	o.actual.OnComplete(ctx)
+	o.release.Dispose()
+}
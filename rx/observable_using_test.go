@@ -0,0 +1,211 @@
+package rx
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testResource records its release along with the signals received by the observer
+type testResource struct {
+	mu     sync.Mutex
+	events []string
+	err    error
+}
+
+func (r *testResource) record(event string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, event)
+}
+
+func (r *testResource) Close() error {
+	r.record("release")
+	return r.err
+}
+
+func (r *testResource) Events() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.events...)
+}
+
+// recordingObserver records the signals it receives to a testResource
+type recordingObserver struct {
+	*testObserver
+	resource *testResource
+}
+
+func (o *recordingObserver) OnNext(ctx context.Context, msg interface{}) {
+	o.resource.record("next")
+	o.testObserver.OnNext(ctx, msg)
+}
+
+func (o *recordingObserver) OnError(ctx context.Context, err error) {
+	o.resource.record("error")
+	o.testObserver.OnError(ctx, err)
+}
+
+func (o *recordingObserver) OnComplete(ctx context.Context) {
+	o.resource.record("complete")
+	o.testObserver.OnComplete(ctx)
+}
+
+func TestUsing(t *testing.T) {
+	usingResource := func(resource *testResource, source ObservableSource, eager bool) Observable {
+		return Using(func() *testResource {
+			return resource
+		}, func(r *testResource) ObservableSource {
+			return source
+		}, nil, eager)
+	}
+
+	tests := []struct {
+		name      string
+		source    ObservableSource
+		eager     bool
+		expect    []string
+		expectErr error
+	}{
+		{name: "CompleteEagerly", source: Just(1), eager: true, expect: []string{"next", "release", "complete"}},
+		{name: "CompleteLazily", source: Just(1), expect: []string{"next", "complete", "release"}},
+		{name: "ErrorEagerly", source: errorObservable(emptyInterfaceType, errTest), eager: true, expect: []string{"release", "error"}, expectErr: errTest},
+		{name: "ErrorLazily", source: errorObservable(emptyInterfaceType, errTest), expect: []string{"error", "release"}, expectErr: errTest},
+	}
+	for _, tt := range tests {
+		t.Run("Using_should_Release_"+tt.name, func(t *testing.T) {
+			resource := &testResource{}
+			ob := &recordingObserver{testObserver: newTestObserver(), resource: resource}
+			usingResource(resource, tt.source, tt.eager).Subscribe(context.Background(), ob)
+			assert.Equal(t, tt.expectErr, ob.Err())
+			assert.Equal(t, tt.expect, resource.Events())
+		})
+	}
+
+	t.Run("Using_should_ReleaseOnDisposal", func(t *testing.T) {
+		resource := &testResource{}
+		value, err := usingResource(resource, Just(1, 2, 3), false).BlockingFirst(context.Background())
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, 1, value)
+		assert.Equal(t, []string{"release"}, resource.Events(), "should release exactly once")
+	})
+
+	t.Run("Using_should_EmitTheReleaseErrorEagerly", func(t *testing.T) {
+		resource := &testResource{err: errTest}
+		err := usingResource(resource, Just(1), true).BlockingForEach(context.Background(), func(int) {})
+		assert.Equal(t, errTest, err)
+	})
+
+	t.Run("Using_should_AggregateTheReleaseErrorEagerly", func(t *testing.T) {
+		other := errors.New("other")
+		resource := &testResource{err: other}
+		err := usingResource(resource, errorObservable(emptyInterfaceType, errTest), true).BlockingForEach(context.Background(), func(int) {})
+		assert.True(t, errors.Is(err, errTest))
+		assert.True(t, errors.Is(err, other))
+	})
+
+	t.Run("Using_should_ReportTheReleaseErrorLazily", func(t *testing.T) {
+		undeliverable := captureUndeliverableErrors(t)
+		resource := &testResource{err: errTest}
+		err := usingResource(resource, Just(1), false).BlockingForEach(context.Background(), func(int) {})
+		assert.NoError(t, err)
+		assert.Equal(t, []error{errTest}, undeliverable())
+	})
+
+	t.Run("Using_should_CallTheDisposer", func(t *testing.T) {
+		var released []int
+		err := Using(func(ctx context.Context) (int, error) {
+			return 42, nil
+		}, func(ctx context.Context, resource int) (ObservableSource, error) {
+			return Just(resource), nil
+		}, func(resource int) {
+			released = append(released, resource)
+		}, true).BlockingForEach(context.Background(), func(int) {})
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, []int{42}, released)
+	})
+
+	t.Run("Using_should_ReleaseWhenTheSourceFactoryFails", func(t *testing.T) {
+		resource := &testResource{}
+		err := Using(func() *testResource {
+			return resource
+		}, func(r *testResource) (ObservableSource, error) {
+			return nil, errTest
+		}, nil, false).BlockingForEach(context.Background(), func(int) {})
+		assert.Equal(t, errTest, err)
+		assert.Equal(t, []string{"release"}, resource.Events())
+	})
+
+	t.Run("Using_should_PropagateResourceFactoryErrors", func(t *testing.T) {
+		err := Using(func() (*testResource, error) {
+			return nil, errTest
+		}, func(r *testResource) ObservableSource {
+			return Just(1)
+		}, nil, false).BlockingForEach(context.Background(), func(int) {})
+		assert.Equal(t, errTest, err)
+	})
+
+	t.Run("Using_should_SignalResourceFactoryPanics", func(t *testing.T) {
+		err := Using(func() *testResource {
+			panic("resource")
+		}, func(r *testResource) ObservableSource {
+			return Just(1)
+		}, nil, false).BlockingForEach(context.Background(), func(int) {})
+		var panicErr *PanicError
+		if assert.ErrorAs(t, err, &panicErr) {
+			assert.Equal(t, "resource", panicErr.Value())
+		}
+	})
+
+	t.Run("Using_should_ReleaseWhenTheSourceFactoryPanics", func(t *testing.T) {
+		resource := &testResource{}
+		err := Using(func() *testResource {
+			return resource
+		}, func(r *testResource) ObservableSource {
+			panic("source")
+		}, nil, false).BlockingForEach(context.Background(), func(int) {})
+		var panicErr *PanicError
+		if assert.ErrorAs(t, err, &panicErr) {
+			assert.Equal(t, "source", panicErr.Value())
+		}
+		assert.Equal(t, []string{"release"}, resource.Events())
+	})
+
+	t.Run("Using_should_SignalDisposerPanics", func(t *testing.T) {
+		err := Using(func() int {
+			return 1
+		}, func(resource int) ObservableSource {
+			return Just(resource)
+		}, func(resource int) {
+			panic("disposer")
+		}, true).BlockingForEach(context.Background(), func(int) {})
+		var panicErr *PanicError
+		if assert.ErrorAs(t, err, &panicErr) {
+			assert.Equal(t, "disposer", panicErr.Value())
+		}
+	})
+
+	t.Run("Using_should_RejectTypedNilSources", func(t *testing.T) {
+		resource := &testResource{}
+		err := Using(func() *testResource {
+			return resource
+		}, func(r *testResource) *ObservableError {
+			return nil
+		}, nil, false).BlockingForEach(context.Background(), func(int) {})
+		assert.EqualError(t, err, "sourceFactory returned a nil ObservableSource")
+		assert.Equal(t, []string{"release"}, resource.Events())
+	})
+
+	t.Run("Using_should_RejectInvalidSourceFactories", func(t *testing.T) {
+		err := Using(func() int { return 1 }, func(i int) int { return i }, nil, false).
+			BlockingForEach(context.Background(), func(int) {})
+		assert.EqualError(t, err, "sourceFactory should return an ObservableSource but got int")
+	})
+}
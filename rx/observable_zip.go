@@ -0,0 +1,216 @@
+package rx
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"sync/atomic"
+
+	"www.github.com/secretworry/rx-go/rx/fun"
+)
+
+var interfaceSliceType = reflect.TypeOf([]interface{}(nil))
+
+// ZipDelayError combines the n-th items of all the sources with zipper, shaped like
+// func([ctx context.Context,] items []interface{}) R [error], and emits the result.
+// An error of a source does not stop the others while items can still be zipped. Once any source terminates without
+// pending items, the other sources are disposed and the errors collected so far are signaled, as a *CompositeError
+// if there are more than one, or ZipDelayError completes if there are none.
+// An error returned by zipper disposes the sources, and is signaled right away along with the errors collected so far
+func ZipDelayError(zipper interface{}, sources ...ObservableSource) Observable {
+	zipperCaller, err := fun.CallerOf(zipper)
+	if err != nil {
+		return errorObservable(emptyInterfaceType, err)
+	}
+	if zipperCaller.ReceiveType() != interfaceSliceType {
+		return errorObservable(emptyInterfaceType, fmt.Errorf("zipper should receive %s but got %s", interfaceSliceType, zipperCaller.ReceiveType()))
+	}
+	return onAssembly((&ObservableZipDelayError{
+		zipper:  zipperCaller,
+		sources: sources,
+	}).Init())
+}
+
+var _ Observable = (*ObservableZipDelayError)(nil)
+
+type ObservableZipDelayError struct {
+	BaseObservable
+	zipper  fun.Caller
+	sources []ObservableSource
+}
+
+func (o *ObservableZipDelayError) Init() *ObservableZipDelayError {
+	o.Self = func() ObservableSource {
+		return o
+	}
+	return o
+}
+
+func (o *ObservableZipDelayError) Type() reflect.Type {
+	return o.zipper.ReturnType()
+}
+
+func (o *ObservableZipDelayError) subscribeActual(ctx context.Context, ob Observer) {
+	z := &zipDelayErrorCoordinator{
+		actual:      ob,
+		zipper:      o.zipper,
+		disposables: NewCompositeDisposable(),
+		queues:      make([][]interface{}, len(o.sources)),
+		done:        make([]bool, len(o.sources)),
+	}
+	ob.OnSubscribe(z.disposables)
+	if len(o.sources) == 0 {
+		ob.OnComplete(ctx)
+		return
+	}
+	for i, source := range o.sources {
+		if z.disposables.IsDisposed() {
+			return
+		}
+		subscribe(ctx, source, &zipInnerObserver{parent: z, index: i, typ: source.Type()})
+	}
+}
+
+// zipDelayErrorCoordinator queues the items of each source, and emits a zipped item once every queue has one.
+// The signals of the sources are enqueued under the lock, and emitted by the goroutine winning the drain loop
+type zipDelayErrorCoordinator struct {
+	actual      Observer
+	zipper      fun.Caller
+	disposables *CompositeDisposable
+	wip         int32
+	terminated  bool
+
+	mu     sync.Mutex
+	queues [][]interface{}
+	done   []bool
+	errs   []error
+	// exhausted is set once a source terminated without pending items, no row can be zipped anymore and the zip is
+	// finished
+	exhausted bool
+}
+
+func (z *zipDelayErrorCoordinator) onNext(ctx context.Context, index int, msg interface{}) {
+	z.mu.Lock()
+	if z.exhausted {
+		z.mu.Unlock()
+		return
+	}
+	z.queues[index] = append(z.queues[index], msg)
+	z.mu.Unlock()
+	z.drain(ctx)
+}
+
+func (z *zipDelayErrorCoordinator) onDone(ctx context.Context, index int, err error) {
+	z.mu.Lock()
+	z.done[index] = true
+	if err != nil {
+		z.errs = append(z.errs, err)
+	}
+	z.mu.Unlock()
+	z.drain(ctx)
+}
+
+func (z *zipDelayErrorCoordinator) drain(ctx context.Context) {
+	if atomic.AddInt32(&z.wip, 1) != 1 {
+		return
+	}
+	for {
+		for !z.terminated {
+			row, finished, err := z.poll()
+			if finished {
+				z.terminated = true
+				z.disposables.Dispose()
+				if err != nil {
+					z.actual.OnError(ctx, err)
+				} else {
+					z.actual.OnComplete(ctx)
+				}
+				break
+			}
+			if row == nil {
+				break
+			}
+			if z.disposables.IsDisposed() {
+				z.terminated = true
+				break
+			}
+			zipped, err := z.zipper.Call(ctx, row)
+			if err != nil {
+				z.terminated = true
+				z.disposables.Dispose()
+				z.mu.Lock()
+				err = compositeErrorOf(append(z.errs, err))
+				z.mu.Unlock()
+				z.actual.OnError(ctx, err)
+				break
+			}
+			z.actual.OnNext(ctx, zipped)
+		}
+		if atomic.AddInt32(&z.wip, -1) == 0 {
+			return
+		}
+	}
+}
+
+// poll dequeues a row of items if every queue has one. Otherwise it reports whether the zip is finished because a
+// source terminated without pending items, along with the errors collected so far
+func (z *zipDelayErrorCoordinator) poll() (row []interface{}, finished bool, err error) {
+	z.mu.Lock()
+	defer z.mu.Unlock()
+	if z.exhausted {
+		return nil, false, nil
+	}
+	ready := true
+	for i, queue := range z.queues {
+		if len(queue) == 0 {
+			ready = false
+			z.exhausted = z.exhausted || z.done[i]
+		}
+	}
+	if ready {
+		row = make([]interface{}, len(z.queues))
+		for i, queue := range z.queues {
+			row[i] = queue[0]
+			queue[0] = nil
+			z.queues[i] = queue[1:]
+		}
+		return row, false, nil
+	}
+	if !z.exhausted {
+		return nil, false, nil
+	}
+	// the pending items can never be zipped
+	for i := range z.queues {
+		z.queues[i] = nil
+	}
+	return nil, true, compositeErrorOf(z.errs)
+}
+
+var _ Observer = (*zipInnerObserver)(nil)
+
+type zipInnerObserver struct {
+	parent *zipDelayErrorCoordinator
+	index  int
+	typ    reflect.Type
+}
+
+func (o *zipInnerObserver) Type() reflect.Type {
+	return o.typ
+}
+
+func (o *zipInnerObserver) OnSubscribe(disposable Disposable) {
+	o.parent.disposables.Add(disposable)
+}
+
+func (o *zipInnerObserver) OnNext(ctx context.Context, msg interface{}) {
+	o.parent.onNext(ctx, o.index, msg)
+}
+
+func (o *zipInnerObserver) OnError(ctx context.Context, err error) {
+	o.parent.onDone(ctx, o.index, err)
+}
+
+func (o *zipInnerObserver) OnComplete(ctx context.Context) {
+	o.parent.onDone(ctx, o.index, nil)
+}
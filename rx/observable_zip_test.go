@@ -0,0 +1,98 @@
+package rx
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestZipDelayError(t *testing.T) {
+	sum := func(items []interface{}) int {
+		total := 0
+		for _, item := range items {
+			total += item.(int)
+		}
+		return total
+	}
+
+	t.Run("ZipDelayError_should_ZipTheNthItems", func(t *testing.T) {
+		var items []int
+		err := ZipDelayError(sum, Just(1, 2, 3), Just(10, 20), Just(100, 200, 300)).
+			BlockingToSlice(context.Background(), &items)
+		assert.NoError(t, err)
+		assert.Equal(t, []int{111, 222}, items)
+	})
+
+	t.Run("ZipDelayError_should_ReportEveryErrorOnceNoMoreItemCanBeZipped", func(t *testing.T) {
+		other := errors.New("other")
+		ob := newTestObserver()
+		ZipDelayError(sum,
+			ConcatDelayError(Just(1, 2), errorObservable(emptyInterfaceType, errTest)),
+			ConcatDelayError(Just(10), errorObservable(emptyInterfaceType, other)),
+		).Subscribe(context.Background(), ob)
+		<-ob.done
+		assert.Equal(t, []interface{}{11}, ob.Items())
+		var composite *CompositeError
+		if assert.True(t, errors.As(ob.Err(), &composite)) {
+			assert.ElementsMatch(t, []error{errTest, other}, composite.Errors())
+		}
+	})
+
+	t.Run("ZipDelayError_should_DisposeTheOtherSourcesOnceNoMoreItemCanBeZipped", func(t *testing.T) {
+		var emitter ObservableEmitter
+		ob := newTestObserver()
+		ZipDelayError(sum,
+			ConcatDelayError(Just(1), errorObservable(emptyInterfaceType, errTest)),
+			Create(func(ctx context.Context, e ObservableEmitter) {
+				emitter = e
+				e.OnNext(ctx, 10)
+				e.OnNext(ctx, 20)
+			}),
+		).Subscribe(context.Background(), ob)
+		<-ob.done
+		assert.Equal(t, []interface{}{11}, ob.Items())
+		assert.Equal(t, errTest, ob.Err())
+		assert.True(t, emitter.IsDisposed())
+	})
+
+	t.Run("ZipDelayError_should_CompleteBesideAnInfiniteSource", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		last, err := ZipDelayError(sum, Just(1), asyncRange(math.MaxInt)).BlockingLast(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, last)
+	})
+
+	t.Run("ZipDelayError_should_StopOnZipperError", func(t *testing.T) {
+		ob := newTestObserver()
+		ZipDelayError(func(items []interface{}) (int, error) {
+			if items[0] == 2 {
+				return 0, errTest
+			}
+			return sum(items), nil
+		}, Just(1, 2, 3), Just(1, 2, 3)).Subscribe(context.Background(), ob)
+		<-ob.done
+		assert.Equal(t, []interface{}{2}, ob.Items())
+		assert.Equal(t, errTest, ob.Err())
+	})
+
+	t.Run("ZipDelayError_should_ZipConcurrentSources", func(t *testing.T) {
+		ob := newTestObserver()
+		ZipDelayError(sum, asyncRange(100), asyncRange(100)).Subscribe(context.Background(), ob)
+		<-ob.done
+		items := ob.Items()
+		if assert.Len(t, items, 100) {
+			assert.Equal(t, 198, items[99])
+		}
+		assert.True(t, ob.Completed())
+	})
+
+	t.Run("ZipDelayError_should_RejectAZipperNotReceivingASlice", func(t *testing.T) {
+		err := ZipDelayError(func(i int) int { return i }, Just(1)).BlockingForEach(context.Background(), func(int) {})
+		assert.Error(t, err)
+	})
+}
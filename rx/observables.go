@@ -2,8 +2,8 @@ package rx
 
 import (
 	"context"
+	"fmt"
 	"reflect"
-	"unsafe"
 
 	"www.github.com/secretworry/rx-go/rx/fun"
 )
@@ -18,14 +18,31 @@ func (b BaseObservable) Type() reflect.Type {
 	return b.Self().Type()
 }
 
-func (b BaseObservable) BlockingForEach(ctx context.Context, consumer interface{}) error {
+// Subscribe subscribes the given observer wrapped by a SafeObserver, so a misbehaving source or a panicking
+// observer cannot break the subscription. The operators subscribe to their sources without it.
+// A type outside of the package embedding BaseObservable should implement its own Subscribe, otherwise an error is
+// signaled to the observer
+func (b BaseObservable) Subscribe(ctx context.Context, ob Observer) {
+	self := b.Self()
+	if _, ok := self.(actualSubscriber); !ok {
+		// subscribe would call self.Subscribe, which is this very method
+		ob.OnSubscribe(Disposables.Empty())
+		ob.OnError(ctx, fmt.Errorf("%T embeds BaseObservable without implementing Subscribe", self))
+		return
+	}
+	subscribe(ctx, self, NewSafeObserver(ob))
+}
+
+// BlockingForEach subscribes to the source and calls consumer for every item until the source terminates.
+// If ctx is done first, the source is disposed and a *CancelledError is returned
+func (b BaseObservable) BlockingForEach(ctx context.Context, consumer interface{}, opts ...BlockingForEachOption) error {
 	runner, err := fun.RunnerOf(consumer)
 	if err != nil {
 		return err
 	}
-	ob := NewBlockingForEachObserver(runner)
+	ob := NewBlockingForEachObserver(runner, opts...)
 	source := b.Self()
-	source.Subscribe(ctx, ob)
+	subscribe(ctx, source, ob)
 	return ob.Wait(ctx)
 }
 
@@ -33,6 +50,7 @@ var _ Observable = (*ObservableOnSubscribe)(nil)
 
 type ObservableOnSubscribe struct {
 	BaseObservable
+	typ         reflect.Type
 	onSubscribe OnSubscribeCall
 }
 
@@ -43,51 +61,132 @@ func (o *ObservableOnSubscribe) Init() *ObservableOnSubscribe {
 	return o
 }
 
-func (o *ObservableOnSubscribe) Subscribe(ctx context.Context, ob Observer) {
-	emitter := &createEmitter{ob: ob}
+// Type reports the element type given to CreateOf, or interface{} if it's not known
+func (o *ObservableOnSubscribe) Type() reflect.Type {
+	if o.typ == nil {
+		return emptyInterfaceType
+	}
+	return o.typ
+}
+
+// subscribeActual calls onSubscribe with a child context of ctx, which is cancelled once the subscription is disposed
+func (o *ObservableOnSubscribe) subscribeActual(ctx context.Context, ob Observer) {
+	child, cancel := context.WithCancel(ctx)
+	emitter := &createEmitter{ob: ob, ctx: ctx, child: child, cancel: cancel}
 	ob.OnSubscribe(emitter)
 	defer func() {
 		if e := recover(); e != nil {
-			emitter.OnError(ctx, toError(e))
+			emitter.OnError(child, toError(e))
 		}
 	}()
-	o.onSubscribe(ctx, emitter)
+	o.onSubscribe(child, emitter)
+}
+
+var _ Observable = (*ObservableError)(nil)
+
+type ObservableError struct {
+	BaseObservable
+	typ func() reflect.Type
+	err error
+}
+
+func (o *ObservableError) Init() *ObservableError {
+	o.Self = func() ObservableSource {
+		return o
+	}
+	return o
+}
+
+func (o *ObservableError) Type() reflect.Type {
+	return o.typ()
+}
+
+func (o *ObservableError) subscribeActual(ctx context.Context, ob Observer) {
+	ob.OnSubscribe(Disposables.Empty())
+	if !isDone(ctx) {
+		ob.OnError(ctx, o.err)
+	}
+}
+
+// errorObservable reports an assembly failure of an operator whose items are of the given type
+func errorObservable(typ reflect.Type, err error) Observable {
+	return onAssembly((&ObservableError{
+		typ: func() reflect.Type {
+			return typ
+		},
+		err: err,
+	}).Init())
+}
+
+// sourceErrorObservable reports an assembly failure of an operator emitting the same type of items as its source
+func sourceErrorObservable(source ObservableSource, err error) Observable {
+	return onAssembly((&ObservableError{
+		typ: source.Type,
+		err: err,
+	}).Init())
 }
 
 var _ Disposable = (*createEmitter)(nil)
 var _ ObservableEmitter = (*createEmitter)(nil)
 
 type createEmitter struct {
-	disposable unsafe.Pointer
+	disposable DisposableRef
 	ob         Observer
+	ctx        context.Context
+	child      context.Context
+	cancel     context.CancelFunc
+}
+
+// signalContext returns the context to signal the observer with. The child context given to onSubscribe is
+// replaced by the context of the subscription, so that disposing the emitter does not cancel its own signals
+func (e *createEmitter) signalContext(ctx context.Context) context.Context {
+	if ctx == e.child {
+		return e.ctx
+	}
+	return ctx
 }
 
 func (e *createEmitter) SetDisposable(disposable Disposable) {
-	DisposableHelper.Set(&e.disposable, &disposable)
+	e.disposable.Set(disposable)
 }
 
 func (e *createEmitter) Dispose() {
-	DisposableHelper.Dispose(&e.disposable)
+	e.disposable.Dispose()
+	e.cancel()
 }
 
 func (e *createEmitter) IsDisposed() bool {
-	return DisposableHelper.IsDisposed(&e.disposable)
+	return e.disposable.IsDisposed()
+}
+
+func (e *createEmitter) Serialize() ObservableEmitter {
+	return &serializedEmitter{
+		emitter:    e,
+		serializer: serializer{target: e},
+	}
 }
 
 func (e *createEmitter) OnNext(ctx context.Context, msg interface{}) {
+	ctx = e.signalContext(ctx)
 	if !e.IsDisposed() && !isDone(ctx) {
 		e.ob.OnNext(ctx, msg)
 	}
 }
 
 func (e *createEmitter) OnError(ctx context.Context, err error) {
-	if !e.IsDisposed() && !isDone(ctx) {
+	ctx = e.signalContext(ctx)
+	if e.IsDisposed() {
+		onUndeliverableError(err)
+		return
+	}
+	if !isDone(ctx) {
 		e.ob.OnError(ctx, err)
 		e.Dispose()
 	}
 }
 
 func (e *createEmitter) OnComplete(ctx context.Context) {
+	ctx = e.signalContext(ctx)
 	if !e.IsDisposed() && !isDone(ctx) {
 		e.ob.OnComplete(ctx)
 		e.Dispose()
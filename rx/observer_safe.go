@@ -0,0 +1,113 @@
+package rx
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync/atomic"
+)
+
+var _ Observer = (*SafeObserver)(nil)
+var _ Disposable = (*SafeObserver)(nil)
+
+// SafeObserver enforces the Observable contract on behalf of the observer it wraps:
+// signals before OnSubscribe, after a terminal signal, or a second OnSubscribe are dropped and reported to the
+// undeliverable error handler. A panic of OnNext disposes the upstream and terminates the observer; since the
+// observer itself failed, the *PanicError is reported to the undeliverable error handler rather than to its OnError,
+// as is a panic of the other methods
+type SafeObserver struct {
+	actual   Observer
+	upstream DisposableRef
+	done     int32
+}
+
+func NewSafeObserver(actual Observer) *SafeObserver {
+	if safe, ok := actual.(*SafeObserver); ok {
+		return safe
+	}
+	return &SafeObserver{actual: actual}
+}
+
+func (o *SafeObserver) Type() reflect.Type {
+	return o.actual.Type()
+}
+
+func (o *SafeObserver) Dispose() {
+	o.upstream.Dispose()
+}
+
+func (o *SafeObserver) IsDisposed() bool {
+	return o.upstream.IsDisposed()
+}
+
+func (o *SafeObserver) OnSubscribe(disposable Disposable) {
+	if !o.upstream.SetOnce(disposable) {
+		onUndeliverableError(fmt.Errorf("%w: OnSubscribe called more than once", ErrProtocolViolation))
+		return
+	}
+	if err := o.call(func() { o.actual.OnSubscribe(o) }); err != nil {
+		atomic.StoreInt32(&o.done, 1)
+		o.Dispose()
+		onUndeliverableError(err)
+	}
+}
+
+func (o *SafeObserver) OnNext(ctx context.Context, msg interface{}) {
+	if atomic.LoadInt32(&o.done) == 1 {
+		return
+	}
+	if o.upstream.Get() == nil {
+		o.violate("OnNext called before OnSubscribe")
+		return
+	}
+	if err := o.call(func() { o.actual.OnNext(ctx, msg) }); err != nil {
+		o.Dispose()
+		atomic.StoreInt32(&o.done, 1)
+		onUndeliverableError(err)
+	}
+}
+
+func (o *SafeObserver) OnError(ctx context.Context, err error) {
+	if !atomic.CompareAndSwapInt32(&o.done, 0, 1) {
+		onUndeliverableError(err)
+		return
+	}
+	if o.upstream.Get() == nil {
+		onUndeliverableError(fmt.Errorf("%w: OnError called before OnSubscribe: %w", ErrProtocolViolation, err))
+		return
+	}
+	if panicErr := o.call(func() { o.actual.OnError(ctx, err) }); panicErr != nil {
+		onUndeliverableError(compositeErrorOf([]error{err, panicErr}))
+	}
+}
+
+func (o *SafeObserver) OnComplete(ctx context.Context) {
+	if !atomic.CompareAndSwapInt32(&o.done, 0, 1) {
+		return
+	}
+	if o.upstream.Get() == nil {
+		onUndeliverableError(fmt.Errorf("%w: OnComplete called before OnSubscribe", ErrProtocolViolation))
+		return
+	}
+	if err := o.call(func() { o.actual.OnComplete(ctx) }); err != nil {
+		onUndeliverableError(err)
+	}
+}
+
+// violate terminates the observer because the source violated the Observable contract
+func (o *SafeObserver) violate(msg string) {
+	if atomic.CompareAndSwapInt32(&o.done, 0, 1) {
+		onUndeliverableError(fmt.Errorf("%w: %s", ErrProtocolViolation, msg))
+	}
+}
+
+// call calls f, and returns the PanicError of its panic if it panics
+func (o *SafeObserver) call(f func()) (err error) {
+	defer func() {
+		if e := recover(); e != nil {
+			err = ErrPanic(e)
+		}
+	}()
+	f()
+	return nil
+}
@@ -0,0 +1,168 @@
+package rx
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// panickingObserver panics in the signal methods it's told to
+type panickingObserver struct {
+	*testObserver
+	onNext     bool
+	onComplete bool
+}
+
+func (o *panickingObserver) OnNext(ctx context.Context, msg interface{}) {
+	if o.onNext {
+		panic("next")
+	}
+	o.testObserver.OnNext(ctx, msg)
+}
+
+func (o *panickingObserver) OnComplete(ctx context.Context) {
+	if o.onComplete {
+		panic("complete")
+	}
+	o.testObserver.OnComplete(ctx)
+}
+
+// misbehavingSource calls the given function with the observer subscribed to it, regardless of the contract. Like
+// the Observables of the package, its Subscribe wraps the observer in a SafeObserver
+func misbehavingSource(f func(ctx context.Context, ob Observer)) Observable {
+	return (&observableFunc{f: f}).Init()
+}
+
+// bareObservable embeds BaseObservable without implementing Subscribe
+type bareObservable struct {
+	BaseObservable
+}
+
+func (o *bareObservable) Type() reflect.Type {
+	return emptyInterfaceType
+}
+
+type observableFunc struct {
+	BaseObservable
+	f func(ctx context.Context, ob Observer)
+}
+
+func (o *observableFunc) Init() *observableFunc {
+	o.Self = func() ObservableSource {
+		return o
+	}
+	return o
+}
+
+func (o *observableFunc) Type() reflect.Type {
+	return emptyInterfaceType
+}
+
+func (o *observableFunc) subscribeActual(ctx context.Context, ob Observer) {
+	o.f(ctx, ob)
+}
+
+func TestSafeObserver(t *testing.T) {
+	t.Run("SafeObserver_should_DropSignalsAfterTermination", func(t *testing.T) {
+		undeliverable := captureUndeliverableErrors(t)
+		ob := newTestObserver()
+		misbehavingSource(func(ctx context.Context, ob Observer) {
+			ob.OnSubscribe(Disposables.Empty())
+			ob.OnNext(ctx, 1)
+			ob.OnComplete(ctx)
+			ob.OnNext(ctx, 2)
+			ob.OnComplete(ctx)
+			ob.OnError(ctx, errTest)
+		}).Subscribe(context.Background(), ob)
+		assert.Equal(t, []interface{}{1}, ob.Items())
+		assert.True(t, ob.Completed())
+		assert.NoError(t, ob.Err())
+		assert.Equal(t, []error{errTest}, undeliverable(), "should report the late error")
+	})
+
+	t.Run("SafeObserver_should_RejectTheSecondOnSubscribe", func(t *testing.T) {
+		undeliverable := captureUndeliverableErrors(t)
+		second := Disposables.Empty()
+		misbehavingSource(func(ctx context.Context, ob Observer) {
+			ob.OnSubscribe(Disposables.Empty())
+			ob.OnSubscribe(second)
+		}).Subscribe(context.Background(), newTestObserver())
+		assert.True(t, second.IsDisposed())
+		if assert.Len(t, undeliverable(), 1) {
+			assert.True(t, errors.Is(undeliverable()[0], ErrProtocolViolation))
+		}
+	})
+
+	t.Run("SafeObserver_should_RejectSignalsBeforeOnSubscribe", func(t *testing.T) {
+		undeliverable := captureUndeliverableErrors(t)
+		ob := newTestObserver()
+		misbehavingSource(func(ctx context.Context, ob Observer) {
+			ob.OnNext(ctx, 1)
+		}).Subscribe(context.Background(), ob)
+		assert.Empty(t, ob.Items())
+		if assert.Len(t, undeliverable(), 1) {
+			assert.True(t, errors.Is(undeliverable()[0], ErrProtocolViolation))
+		}
+	})
+
+	t.Run("SafeObserver_should_DisposeTheUpstreamWhenOnNextPanics", func(t *testing.T) {
+		undeliverable := captureUndeliverableErrors(t)
+		ob := &panickingObserver{testObserver: newTestObserver(), onNext: true}
+		var emitter ObservableEmitter
+		Create(func(ctx context.Context, e ObservableEmitter) {
+			emitter = e
+			e.OnNext(ctx, 1)
+			e.OnNext(ctx, 2)
+			e.OnComplete(ctx)
+		}).Subscribe(context.Background(), ob)
+		assert.True(t, emitter.IsDisposed())
+		assert.NoError(t, ob.Err(), "should not signal the panic to the observer that panicked")
+		assert.False(t, ob.Completed(), "should terminate the observer")
+		if assert.Len(t, undeliverable(), 1) {
+			var panicErr *PanicError
+			if assert.True(t, errors.As(undeliverable()[0], &panicErr)) {
+				assert.Equal(t, "next", panicErr.Value())
+			}
+		}
+	})
+
+	t.Run("Subscribe_should_FailIfTheEmbeddingTypeDoesNotImplementIt", func(t *testing.T) {
+		source := &bareObservable{}
+		source.Self = func() ObservableSource {
+			return source
+		}
+		ob := newTestObserver()
+		assert.NotPanics(t, func() {
+			source.Subscribe(context.Background(), ob)
+		})
+		assert.EqualError(t, ob.Err(), "*rx.bareObservable embeds BaseObservable without implementing Subscribe")
+	})
+
+	t.Run("Subscribe_should_WrapTheObserverInASafeObserver", func(t *testing.T) {
+		undeliverable := captureUndeliverableErrors(t)
+		ob := &panickingObserver{testObserver: newTestObserver(), onNext: true}
+		var emitter ObservableEmitter
+		assert.NotPanics(t, func() {
+			Create(func(ctx context.Context, e ObservableEmitter) {
+				emitter = e
+				e.OnNext(ctx, 1)
+			}).Distinct().Subscribe(context.Background(), ob)
+		})
+		assert.True(t, emitter.IsDisposed())
+		assert.Len(t, undeliverable(), 1)
+	})
+
+	t.Run("SafeObserver_should_ReportPanicsOfOnComplete", func(t *testing.T) {
+		undeliverable := captureUndeliverableErrors(t)
+		ob := &panickingObserver{testObserver: newTestObserver(), onComplete: true}
+		assert.NotPanics(t, func() {
+			Just(1).Subscribe(context.Background(), ob)
+		})
+		if assert.Len(t, undeliverable(), 1) {
+			assert.EqualError(t, undeliverable()[0], "panic: complete")
+		}
+	})
+}
@@ -0,0 +1,138 @@
+package rx
+
+import (
+	"context"
+	"reflect"
+	"sync"
+)
+
+// serializedSignal is a signal queued with the context it was emitted with
+type serializedSignal struct {
+	ctx context.Context
+	signal
+}
+
+// serializer relays the signals emitted concurrently to the target one at a time. The goroutine winning the
+// emission loop emits its own signal, then drains the signals queued meanwhile by the others, without holding the
+// lock while calling the target
+type serializer struct {
+	target Emitter
+
+	mu       sync.Mutex
+	emitting bool
+	done     bool
+	queue    []serializedSignal
+}
+
+func (s *serializer) OnNext(ctx context.Context, msg interface{}) {
+	s.emit(serializedSignal{ctx: ctx, signal: signal{value: msg}})
+}
+
+func (s *serializer) OnError(ctx context.Context, err error) {
+	s.emit(serializedSignal{ctx: ctx, signal: signal{err: err, done: true}})
+}
+
+func (s *serializer) OnComplete(ctx context.Context) {
+	s.emit(serializedSignal{ctx: ctx, signal: signal{done: true}})
+}
+
+func (s *serializer) emit(sig serializedSignal) {
+	s.mu.Lock()
+	if s.done {
+		s.mu.Unlock()
+		return
+	}
+	s.done = sig.done
+	if s.emitting {
+		s.queue = append(s.queue, sig)
+		s.mu.Unlock()
+		return
+	}
+	s.emitting = true
+	s.mu.Unlock()
+	drained := false
+	defer func() {
+		if !drained {
+			// the target panicked: drop the queued signals and leave the loop, so the next signals are not queued
+			// forever behind a goroutine that is gone
+			s.mu.Lock()
+			s.emitting = false
+			s.queue = nil
+			s.mu.Unlock()
+		}
+	}()
+	s.deliver(sig)
+	for {
+		s.mu.Lock()
+		queue := s.queue
+		if len(queue) == 0 {
+			s.emitting = false
+			drained = true
+			s.mu.Unlock()
+			return
+		}
+		s.queue = nil
+		s.mu.Unlock()
+		for _, queued := range queue {
+			s.deliver(queued)
+		}
+	}
+}
+
+func (s *serializer) deliver(sig serializedSignal) {
+	switch {
+	case !sig.done:
+		s.target.OnNext(sig.ctx, sig.value)
+	case sig.err != nil:
+		s.target.OnError(sig.ctx, sig.err)
+	default:
+		s.target.OnComplete(sig.ctx)
+	}
+}
+
+var _ Observer = (*SerializedObserver)(nil)
+
+// SerializedObserver makes it safe to signal the observer it wraps from several goroutines, the wrapped observer
+// receives the signals one at a time, and nothing after the first terminal signal
+type SerializedObserver struct {
+	actual Observer
+	serializer
+}
+
+func NewSerializedObserver(actual Observer) *SerializedObserver {
+	if serialized, ok := actual.(*SerializedObserver); ok {
+		return serialized
+	}
+	return &SerializedObserver{
+		actual:     actual,
+		serializer: serializer{target: actual},
+	}
+}
+
+func (o *SerializedObserver) Type() reflect.Type {
+	return o.actual.Type()
+}
+
+func (o *SerializedObserver) OnSubscribe(disposable Disposable) {
+	o.actual.OnSubscribe(disposable)
+}
+
+var _ ObservableEmitter = (*serializedEmitter)(nil)
+
+// serializedEmitter is an ObservableEmitter that can be called from several goroutines
+type serializedEmitter struct {
+	emitter ObservableEmitter
+	serializer
+}
+
+func (e *serializedEmitter) SetDisposable(disposable Disposable) {
+	e.emitter.SetDisposable(disposable)
+}
+
+func (e *serializedEmitter) IsDisposed() bool {
+	return e.emitter.IsDisposed()
+}
+
+func (e *serializedEmitter) Serialize() ObservableEmitter {
+	return e
+}
@@ -0,0 +1,119 @@
+package rx
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// exclusiveObserver records whether it's ever called concurrently
+type exclusiveObserver struct {
+	*testObserver
+	calling    int32
+	concurrent int32
+}
+
+func (o *exclusiveObserver) OnNext(ctx context.Context, msg interface{}) {
+	if !atomic.CompareAndSwapInt32(&o.calling, 0, 1) {
+		atomic.StoreInt32(&o.concurrent, 1)
+		return
+	}
+	defer atomic.StoreInt32(&o.calling, 0)
+	o.testObserver.OnNext(ctx, msg)
+}
+
+func TestObservableEmitter_Serialize(t *testing.T) {
+	t.Run("Serialize_should_SerializeConcurrentProducers", func(t *testing.T) {
+		const workers, items = 8, 200
+		ob := &exclusiveObserver{testObserver: newTestObserver()}
+		Create(func(ctx context.Context, emitter ObservableEmitter) {
+			serialized := emitter.Serialize()
+			var wg sync.WaitGroup
+			for w := 0; w < workers; w++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					for i := 0; i < items; i++ {
+						serialized.OnNext(ctx, i)
+					}
+				}()
+			}
+			wg.Wait()
+			serialized.OnComplete(ctx)
+		}).Subscribe(context.Background(), ob)
+		assert.Equal(t, int32(0), atomic.LoadInt32(&ob.concurrent), "should never call the observer concurrently")
+		assert.Len(t, ob.Items(), workers*items)
+		assert.True(t, ob.Completed())
+	})
+
+	t.Run("Serialize_should_KeepTheEmitterDisposable", func(t *testing.T) {
+		ob := newTestObserver()
+		var serialized ObservableEmitter
+		Create(func(ctx context.Context, emitter ObservableEmitter) {
+			serialized = emitter.Serialize()
+		}).Subscribe(context.Background(), ob)
+		ob.Dispose()
+		assert.True(t, serialized.IsDisposed())
+		assert.Equal(t, serialized, serialized.Serialize())
+	})
+}
+
+// reentrantObserver emits to the given emitter from its OnNext
+type reentrantObserver struct {
+	*testObserver
+	emitter Emitter
+}
+
+func (o *reentrantObserver) OnNext(ctx context.Context, msg interface{}) {
+	o.testObserver.OnNext(ctx, msg)
+	if msg == 1 {
+		o.emitter.OnNext(ctx, 3)
+		o.testObserver.OnNext(ctx, 2)
+	}
+}
+
+func TestSerializedObserver(t *testing.T) {
+	t.Run("SerializedObserver_should_DropSignalsAfterTermination", func(t *testing.T) {
+		ctx := context.Background()
+		actual := newTestObserver()
+		ob := NewSerializedObserver(actual)
+		ob.OnSubscribe(Disposables.Empty())
+		ob.OnNext(ctx, 1)
+		ob.OnError(ctx, errTest)
+		ob.OnNext(ctx, 2)
+		ob.OnComplete(ctx)
+		assert.Equal(t, []interface{}{1}, actual.Items())
+		assert.Equal(t, errTest, actual.Err())
+		assert.False(t, actual.Completed())
+	})
+
+	t.Run("SerializedObserver_should_QueueReentrantSignals", func(t *testing.T) {
+		ctx := context.Background()
+		actual := &reentrantObserver{testObserver: newTestObserver()}
+		ob := NewSerializedObserver(actual)
+		actual.emitter = ob
+		ob.OnSubscribe(Disposables.Empty())
+		ob.OnNext(ctx, 1)
+		assert.Equal(t, []interface{}{1, 2, 3}, actual.Items(), "should emit the reentrant signal afterwards")
+	})
+
+	t.Run("SerializedObserver_should_KeepEmittingAfterTheObserverPanics", func(t *testing.T) {
+		ctx := context.Background()
+		actual := &panickingObserver{testObserver: newTestObserver(), onNext: true}
+		ob := NewSerializedObserver(actual)
+		ob.OnSubscribe(Disposables.Empty())
+		assert.Panics(t, func() {
+			ob.OnNext(ctx, 1)
+		})
+		ob.OnError(ctx, errTest)
+		assert.Equal(t, errTest, actual.Err(), "should not queue the signals behind the panicked emission")
+	})
+
+	t.Run("NewSerializedObserver_should_NotWrapTwice", func(t *testing.T) {
+		ob := NewSerializedObserver(newTestObserver())
+		assert.Equal(t, ob, NewSerializedObserver(ob))
+	})
+}
@@ -3,7 +3,7 @@ package rx
 import (
 	"context"
 	"reflect"
-	"unsafe"
+	"sync/atomic"
 
 	"www.github.com/secretworry/rx-go/rx/fun"
 )
@@ -12,36 +12,57 @@ var _ Disposable = (*BlockingForEachObserver)(nil)
 var _ Observer = (*BlockingForEachObserver)(nil)
 
 type BlockingForEachObserver struct {
-	disposable unsafe.Pointer
+	disposable DisposableRef
 	consumer   fun.Runner
 	notify     chan error
+	consumed   int64
+	options    blockingForEachOptions
 }
 
-func NewBlockingForEachObserver(consumer fun.Runner) *BlockingForEachObserver {
-	return &BlockingForEachObserver{
+type BlockingForEachOption func(options *blockingForEachOptions)
+
+type blockingForEachOptions struct {
+	nilOnCancel bool
+}
+
+// ReturnNilOnCancel restores the former behaviour of Wait, which returns nil without disposing the source when
+// the context is done
+func ReturnNilOnCancel() BlockingForEachOption {
+	return func(options *blockingForEachOptions) {
+		options.nilOnCancel = true
+	}
+}
+
+func NewBlockingForEachObserver(consumer fun.Runner, opts ...BlockingForEachOption) *BlockingForEachObserver {
+	f := &BlockingForEachObserver{
 		consumer: consumer,
 		notify:   make(chan error, 1),
 	}
+	for _, opt := range opts {
+		opt(&f.options)
+	}
+	return f
 }
 
-func (f BlockingForEachObserver) Type() reflect.Type {
+func (f *BlockingForEachObserver) Type() reflect.Type {
 	return f.consumer.ReceiveType()
 }
 
 func (f *BlockingForEachObserver) Dispose() {
-	DisposableHelper.Dispose(&f.disposable)
+	f.disposable.Dispose()
 }
 
 func (f *BlockingForEachObserver) IsDisposed() bool {
-	return DisposableHelper.IsDisposed(&f.disposable)
+	return f.disposable.IsDisposed()
 }
 
 func (f *BlockingForEachObserver) OnSubscribe(disposable Disposable) {
-	DisposableHelper.SetOnce(&f.disposable, &disposable)
+	f.disposable.SetOnce(disposable)
 }
 
 func (f *BlockingForEachObserver) OnNext(ctx context.Context, msg interface{}) {
 	if !f.IsDisposed() && !isDone(ctx) {
+		atomic.AddInt64(&f.consumed, 1)
 		err := f.consumer.Run(ctx, msg)
 		if err != nil {
 			f.dispose(err)
@@ -56,7 +77,7 @@ func (f *BlockingForEachObserver) OnError(ctx context.Context, err error) {
 }
 
 func (f *BlockingForEachObserver) dispose(err error) {
-	if DisposableHelper.Dispose(&f.disposable) {
+	if f.disposable.TryDispose() {
 		f.notify <- err
 		close(f.notify)
 	}
@@ -68,11 +89,73 @@ func (f *BlockingForEachObserver) OnComplete(ctx context.Context) {
 	}
 }
 
+// Wait blocks until the source terminates, and returns the error terminating it.
+// If ctx is done first, it disposes the source and returns a *CancelledError
 func (f *BlockingForEachObserver) Wait(ctx context.Context) error {
+	select {
+	case err := <-f.notify:
+		return err
+	default:
+	}
 	select {
 	case <-ctx.Done():
-		return nil
+		if f.options.nilOnCancel {
+			return nil
+		}
+		f.Dispose()
+		return &CancelledError{
+			Err:      ctx.Err(),
+			Consumed: int(atomic.LoadInt64(&f.consumed)),
+		}
 	case err := <-f.notify:
 		return err
 	}
 }
+
+// basicObserver keeps the upstream Disposable of an operator and relays the terminal signals downstream at most
+// once. Operators embed it and implement Type and OnNext
+type basicObserver struct {
+	actual   Observer
+	upstream DisposableRef
+	done     int32
+}
+
+func (o *basicObserver) Dispose() {
+	o.upstream.Dispose()
+}
+
+func (o *basicObserver) IsDisposed() bool {
+	return o.upstream.IsDisposed()
+}
+
+func (o *basicObserver) OnSubscribe(disposable Disposable) {
+	if o.upstream.SetOnce(disposable) {
+		o.actual.OnSubscribe(o)
+	}
+}
+
+func (o *basicObserver) OnError(ctx context.Context, err error) {
+	if o.terminate() {
+		o.actual.OnError(ctx, err)
+	}
+}
+
+func (o *basicObserver) OnComplete(ctx context.Context) {
+	if o.terminate() {
+		o.actual.OnComplete(ctx)
+	}
+}
+
+// fail disposes the upstream and signals the error downstream, it's used when the operator itself fails
+func (o *basicObserver) fail(ctx context.Context, err error) {
+	o.Dispose()
+	o.OnError(ctx, err)
+}
+
+func (o *basicObserver) terminate() bool {
+	return atomic.CompareAndSwapInt32(&o.done, 0, 1)
+}
+
+func (o *basicObserver) isTerminated() bool {
+	return atomic.LoadInt32(&o.done) == 1
+}
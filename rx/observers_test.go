@@ -0,0 +1,64 @@
+package rx
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockingForEachObserver_Wait(t *testing.T) {
+	// infinite emits increasing integers from a new goroutine until it's disposed, and closes stopped afterwards
+	infinite := func(stopped chan struct{}) Observable {
+		return Create(func(ctx context.Context, ob ObservableEmitter) {
+			go func() {
+				defer close(stopped)
+				for i := 0; !ob.IsDisposed(); i++ {
+					ob.OnNext(ctx, i)
+				}
+			}()
+		})
+	}
+
+	t.Run("Wait_should_ReturnCancelledErrorWhenTheContextIsDone", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		stopped := make(chan struct{})
+		n := 0
+		err := infinite(stopped).BlockingForEach(ctx, func(i int) {
+			n++
+			if n == 3 {
+				cancel()
+			}
+		})
+		var cancelled *CancelledError
+		if !assert.True(t, errors.As(err, &cancelled), "expect a *CancelledError but got %v", err) {
+			return
+		}
+		assert.True(t, errors.Is(err, context.Canceled), "should unwrap to the error of the context")
+		assert.Equal(t, 3, cancelled.Consumed)
+		<-stopped
+	})
+
+	t.Run("Wait_should_ReturnTheResultIfTheSourceTerminatedBeforeTheContext", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		ret := new([]int)
+		err := Create(func(ctx context.Context, ob ObservableEmitter) {
+			ob.OnNext(ctx, 1)
+			ob.OnComplete(ctx)
+			cancel()
+		}).BlockingToSlice(ctx, ret)
+		assert.NoError(t, err)
+		assert.Equal(t, []int{1}, *ret)
+	})
+
+	t.Run("Wait_should_ReturnNilOnCancelWithTheCompatibilityOption", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		err := Create(func(ctx context.Context, ob ObservableEmitter) {
+			cancel()
+		}).BlockingForEach(ctx, func(i int) {}, ReturnNilOnCancel())
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,183 @@
+package rx
+
+import (
+	"context"
+	"log"
+	"sync/atomic"
+)
+
+// Plugins registers global hooks, to handle the errors that cannot be delivered, or to decorate every Observable
+// and subscription centrally. Setting a nil hook restores the default behaviour, and Reset restores all of them
+var Plugins = struct {
+	// SetErrorHandler sets the handler of the errors that cannot be delivered to anyone, such as an error signaled
+	// after disposal, or the error of a Disposable disposed by Dispose rather than DisposeErr.
+	// The default handler prints them with the standard log package, prefixed with "rx: undeliverable error: ", so
+	// they are never lost silently; set a handler doing nothing to discard them
+	SetErrorHandler func(handler func(err error))
+	// SetOnObservableAssembly sets a hook decorating every Observable created by a factory or an operator
+	SetOnObservableAssembly func(hook func(observable Observable) Observable)
+	// SetOnConnectableObservableAssembly sets a hook decorating every ConnectableObservable created by Publish or
+	// Replay, which the Observable assembly hook cannot replace without losing Connect
+	SetOnConnectableObservableAssembly func(hook func(connectable ConnectableObservable) ConnectableObservable)
+	// SetOnFlowableAssembly sets a hook decorating every Flowable created by a factory or an operator
+	SetOnFlowableAssembly func(hook func(flowable Flowable) Flowable)
+	// SetOnObservableSubscribe sets a hook decorating every Observer subscribing to an ObservableSource
+	SetOnObservableSubscribe func(hook func(source ObservableSource, ob Observer) Observer)
+	// SetOnFlowableSubscribe sets a hook decorating every Subscriber subscribing to a Publisher
+	SetOnFlowableSubscribe func(hook func(source Publisher, s Subscriber) Subscriber)
+	// SetScheduleHandler sets a hook decorating every function run on another goroutine or by a timer
+	SetScheduleHandler func(hook func(run func()) func())
+	// SetAssemblyTracking enables or disables the tracking of the Observables assembled from now on. A tracked
+	// Observable or Flowable decorates the errors going through it with an *AssemblyTrace listing where each one of
+	// the chain was assembled. It captures the stack on each assembly, so it's meant for debugging
+	SetAssemblyTracking func(enabled bool)
+	// Reset restores all the default hooks
+	Reset func()
+}{
+	SetErrorHandler: func(handler func(err error)) {
+		storeHook(&hooks.errorHandler, handler, handler == nil)
+	},
+	SetOnObservableAssembly: func(hook func(observable Observable) Observable) {
+		storeHook(&hooks.onObservableAssembly, hook, hook == nil)
+	},
+	SetOnConnectableObservableAssembly: func(hook func(connectable ConnectableObservable) ConnectableObservable) {
+		storeHook(&hooks.onConnectableObservableAssembly, hook, hook == nil)
+	},
+	SetOnFlowableAssembly: func(hook func(flowable Flowable) Flowable) {
+		storeHook(&hooks.onFlowableAssembly, hook, hook == nil)
+	},
+	SetOnObservableSubscribe: func(hook func(source ObservableSource, ob Observer) Observer) {
+		storeHook(&hooks.onObservableSubscribe, hook, hook == nil)
+	},
+	SetOnFlowableSubscribe: func(hook func(source Publisher, s Subscriber) Subscriber) {
+		storeHook(&hooks.onFlowableSubscribe, hook, hook == nil)
+	},
+	SetScheduleHandler: func(hook func(run func()) func()) {
+		storeHook(&hooks.scheduleHandler, hook, hook == nil)
+	},
+	SetAssemblyTracking: func(enabled bool) {
+		hooks.assemblyTracking.Store(enabled)
+	},
+	Reset: func() {
+		hooks.errorHandler.Store(nil)
+		hooks.onObservableAssembly.Store(nil)
+		hooks.onConnectableObservableAssembly.Store(nil)
+		hooks.onFlowableAssembly.Store(nil)
+		hooks.onObservableSubscribe.Store(nil)
+		hooks.onFlowableSubscribe.Store(nil)
+		hooks.scheduleHandler.Store(nil)
+		hooks.assemblyTracking.Store(false)
+	},
+}
+
+var hooks struct {
+	errorHandler                    atomic.Pointer[func(err error)]
+	onObservableAssembly            atomic.Pointer[func(observable Observable) Observable]
+	onConnectableObservableAssembly atomic.Pointer[func(connectable ConnectableObservable) ConnectableObservable]
+	onFlowableAssembly              atomic.Pointer[func(flowable Flowable) Flowable]
+	onObservableSubscribe           atomic.Pointer[func(source ObservableSource, ob Observer) Observer]
+	onFlowableSubscribe             atomic.Pointer[func(source Publisher, s Subscriber) Subscriber]
+	scheduleHandler                 atomic.Pointer[func(run func()) func()]
+	assemblyTracking                atomic.Bool
+}
+
+func storeHook[T any](ptr *atomic.Pointer[T], hook T, isNil bool) {
+	if isNil {
+		ptr.Store(nil)
+		return
+	}
+	ptr.Store(&hook)
+}
+
+// onUndeliverableError hands err to the handler set with Plugins.SetErrorHandler, or logs it with log.Printf
+func onUndeliverableError(err error) {
+	if handler := hooks.errorHandler.Load(); handler != nil {
+		(*handler)(err)
+		return
+	}
+	log.Printf("rx: undeliverable error: %v", err)
+}
+
+// onAssembly applies the assembly tracking and the assembly hook to an Observable being created
+func onAssembly(observable Observable) Observable {
+	if hooks.assemblyTracking.Load() {
+		observable = (&ObservableOnAssembly{source: observable, site: callerAssemblySite()}).Init()
+	}
+	if hook := hooks.onObservableAssembly.Load(); hook != nil {
+		return (*hook)(observable)
+	}
+	return observable
+}
+
+// onConnectableAssembly applies the assembly tracking and the connectable assembly hook to a ConnectableObservable
+// being created
+func onConnectableAssembly(connectable ConnectableObservable) ConnectableObservable {
+	if hooks.assemblyTracking.Load() {
+		connectable = newConnectableObservableOnAssembly(connectable, callerAssemblySite())
+	}
+	if hook := hooks.onConnectableObservableAssembly.Load(); hook != nil {
+		return (*hook)(connectable)
+	}
+	return connectable
+}
+
+// onFlowableAssembly applies the assembly tracking and the Flowable assembly hook to a Flowable being created
+func onFlowableAssembly(flowable Flowable) Flowable {
+	if hooks.assemblyTracking.Load() {
+		flowable = (&FlowableOnAssembly{source: flowable, site: callerAssemblySite()}).Init()
+	}
+	if hook := hooks.onFlowableAssembly.Load(); hook != nil {
+		return (*hook)(flowable)
+	}
+	return flowable
+}
+
+// actualSubscriber is implemented by the Observables of this package, whose Subscribe wraps the observer in a
+// SafeObserver before calling subscribeActual
+type actualSubscriber interface {
+	subscribeActual(ctx context.Context, ob Observer)
+}
+
+// subscribe subscribes ob to source after applying the subscribe hook. The Observables of this package are
+// subscribed without a SafeObserver, the operators being trusted to follow the Observable contract
+func subscribe(ctx context.Context, source ObservableSource, ob Observer) {
+	if hook := hooks.onObservableSubscribe.Load(); hook != nil {
+		ob = (*hook)(source, ob)
+	}
+	if actual, ok := source.(actualSubscriber); ok {
+		actual.subscribeActual(ctx, ob)
+		return
+	}
+	source.Subscribe(ctx, ob)
+}
+
+// flowableActualSubscriber is implemented by the Flowables of this package, whose Subscribe applies the Flowable
+// subscribe hook before calling subscribeActual
+type flowableActualSubscriber interface {
+	subscribeActual(ctx context.Context, s Subscriber)
+}
+
+// subscribeFlowable subscribes s to source after applying the Flowable subscribe hook
+func subscribeFlowable(ctx context.Context, source Publisher, s Subscriber) {
+	if hook := hooks.onFlowableSubscribe.Load(); hook != nil {
+		s = (*hook)(source, s)
+	}
+	if actual, ok := source.(flowableActualSubscriber); ok {
+		actual.subscribeActual(ctx, s)
+		return
+	}
+	source.Subscribe(ctx, s)
+}
+
+// onSchedule applies the schedule hook to a function to run on another goroutine or by a timer
+func onSchedule(run func()) func() {
+	if hook := hooks.scheduleHandler.Load(); hook != nil {
+		return (*hook)(run)
+	}
+	return run
+}
+
+// schedule runs the given function on a new goroutine
+func schedule(run func()) {
+	go onSchedule(run)()
+}
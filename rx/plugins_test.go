@@ -0,0 +1,151 @@
+package rx
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"math"
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlugins(t *testing.T) {
+	t.Run("SetErrorHandler_should_ReceiveErrorsAfterDisposal", func(t *testing.T) {
+		undeliverable := captureUndeliverableErrors(t)
+		ob := newTestObserver()
+		Create(func(ctx context.Context, emitter ObservableEmitter) {
+			emitter.OnComplete(ctx)
+			emitter.OnError(ctx, errTest)
+		}).Subscribe(context.Background(), ob)
+		assert.True(t, ob.Completed())
+		assert.Equal(t, []error{errTest}, undeliverable())
+	})
+
+	t.Run("DefaultErrorHandler_should_LogUndeliverableErrors", func(t *testing.T) {
+		var output bytes.Buffer
+		log.SetOutput(&output)
+		t.Cleanup(func() {
+			log.SetOutput(os.Stderr)
+		})
+		onUndeliverableError(errTest)
+		assert.Contains(t, output.String(), "rx: undeliverable error: test")
+	})
+
+	t.Run("SetOnObservableAssembly_should_DecorateEveryObservable", func(t *testing.T) {
+		t.Cleanup(Plugins.Reset)
+		var assembled int32
+		Plugins.SetOnObservableAssembly(func(observable Observable) Observable {
+			atomic.AddInt32(&assembled, 1)
+			return observable
+		})
+		Just(1, 2).Distinct().Count()
+		assert.Equal(t, int32(3), atomic.LoadInt32(&assembled))
+	})
+
+	t.Run("SetOnObservableAssembly_should_ReplaceTheObservable", func(t *testing.T) {
+		t.Cleanup(Plugins.Reset)
+		replacement := Just(42)
+		Plugins.SetOnObservableAssembly(func(observable Observable) Observable {
+			if observable.Type() == intType {
+				return replacement
+			}
+			return observable
+		})
+		value, err := Range(0, 3).BlockingFirst(context.Background())
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, 42, value)
+	})
+
+	t.Run("SetOnObservableSubscribe_should_DecorateEveryObserver", func(t *testing.T) {
+		t.Cleanup(Plugins.Reset)
+		var subscribed int32
+		Plugins.SetOnObservableSubscribe(func(source ObservableSource, ob Observer) Observer {
+			atomic.AddInt32(&subscribed, 1)
+			return ob
+		})
+		Just(1).Distinct().Subscribe(context.Background(), newTestObserver())
+		assert.Equal(t, int32(2), atomic.LoadInt32(&subscribed), "should apply to the operators too")
+	})
+
+	t.Run("SetOnConnectableObservableAssembly_should_DecoratePublishAndReplay", func(t *testing.T) {
+		t.Cleanup(Plugins.Reset)
+		var assembled int32
+		Plugins.SetOnConnectableObservableAssembly(func(connectable ConnectableObservable) ConnectableObservable {
+			atomic.AddInt32(&assembled, 1)
+			return connectable
+		})
+		Just(1).Publish()
+		Just(1).Replay(0, 0)
+		assert.Equal(t, int32(2), atomic.LoadInt32(&assembled))
+	})
+
+	t.Run("SetOnFlowableAssembly_should_DecorateEveryFlowable", func(t *testing.T) {
+		t.Cleanup(Plugins.Reset)
+		var assembled int32
+		Plugins.SetOnFlowableAssembly(func(flowable Flowable) Flowable {
+			atomic.AddInt32(&assembled, 1)
+			return flowable
+		})
+		Just(1).ToFlowable(BackpressureMissing).OnBackpressureBuffer(0, nil, BackpressureOverflowError).
+			OnBackpressureDrop(nil).OnBackpressureLatest()
+		assert.Equal(t, int32(4), atomic.LoadInt32(&assembled))
+	})
+
+	t.Run("SetOnFlowableSubscribe_should_DecorateEverySubscriber", func(t *testing.T) {
+		t.Cleanup(Plugins.Reset)
+		var mu sync.Mutex
+		var subscribers []Subscriber
+		Plugins.SetOnFlowableSubscribe(func(source Publisher, s Subscriber) Subscriber {
+			mu.Lock()
+			defer mu.Unlock()
+			subscribers = append(subscribers, s)
+			return s
+		})
+		s := newTCKSubscriber(math.MaxInt64)
+		Just(1).ToFlowable(BackpressureMissing).OnBackpressureLatest().Subscribe(context.Background(), s)
+		if s.awaitTermination(t) {
+			assert.Equal(t, []interface{}{1}, s.Items())
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		if assert.Len(t, subscribers, 2) {
+			assert.Same(t, s, subscribers[0], "should decorate the subscriber of the user")
+		}
+	})
+
+	t.Run("SetScheduleHandler_should_DecorateScheduledFunctions", func(t *testing.T) {
+		t.Cleanup(Plugins.Reset)
+		var scheduled int32
+		Plugins.SetScheduleHandler(func(run func()) func() {
+			atomic.AddInt32(&scheduled, 1)
+			return run
+		})
+		it := Just(1).BlockingIterable(context.Background(), 0)
+		defer it.Close()
+		assert.True(t, it.Next())
+		assert.Equal(t, int32(1), atomic.LoadInt32(&scheduled))
+	})
+
+	t.Run("Reset_should_RestoreTheDefaultHooks", func(t *testing.T) {
+		var called int32
+		Plugins.SetOnObservableAssembly(func(observable Observable) Observable {
+			atomic.AddInt32(&called, 1)
+			return observable
+		})
+		Plugins.SetScheduleHandler(func(run func()) func() {
+			atomic.AddInt32(&called, 1)
+			return run
+		})
+		Plugins.Reset()
+		ob := newTestObserver()
+		Just(1).Publish().RefCount(1, time.Millisecond).Subscribe(context.Background(), ob)
+		assert.Equal(t, int32(0), atomic.LoadInt32(&called))
+	})
+}
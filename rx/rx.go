@@ -2,7 +2,9 @@ package rx
 
 import (
 	"context"
+	"iter"
 	"reflect"
+	"time"
 )
 
 type Observer interface {
@@ -14,7 +16,42 @@ type Observer interface {
 }
 
 type ObservableOperators interface {
-	BlockingForEach(ctx context.Context, consumer interface{}) error
+	BlockingForEach(ctx context.Context, consumer interface{}, opts ...BlockingForEachOption) error
+	BlockingFirst(ctx context.Context) (interface{}, error)
+	BlockingLast(ctx context.Context) (interface{}, error)
+	BlockingSingle(ctx context.Context) (interface{}, error)
+	BlockingToSlice(ctx context.Context, target interface{}) error
+	BlockingToMap(ctx context.Context, target interface{}, keySelector, valueSelector interface{}) error
+	BlockingSubscribe(ctx context.Context, onNext interface{}, onError func(err error), onComplete func())
+	BlockingIterable(ctx context.Context, prefetch int) Iterator
+	ToChannel(ctx context.Context, bufferSize int) (interface{}, <-chan error)
+	Seq(ctx context.Context) iter.Seq2[interface{}, error]
+	GroupBy(keySelector interface{}, opts ...GroupByOption) Observable
+	Distinct(opts ...DistinctOption) Observable
+	DistinctUntilChanged(opts ...DistinctOption) Observable
+	Count() Observable
+	Sum() Observable
+	Min() Observable
+	Max() Observable
+	Average() Observable
+	All(predicate interface{}) Observable
+	Any(predicate interface{}) Observable
+	Contains(value interface{}) Observable
+	IsEmpty() Observable
+	SequenceEqual(other ObservableSource) Observable
+	First() Observable
+	FirstOrDefault(value interface{}) Observable
+	Last() Observable
+	ElementAt(index int) Observable
+	Single() Observable
+	DefaultIfEmpty(value interface{}) Observable
+	SwitchIfEmpty(other ObservableSource) Observable
+	Publish() ConnectableObservable
+	Replay(bufferSize int, window time.Duration) ConnectableObservable
+	Share() Observable
+	Cache() Observable
+	Checkpoint(description string) Observable
+	ToFlowable(strategy BackpressureStrategy) Flowable
 }
 
 type ObservableSource interface {
@@ -38,18 +75,37 @@ type ObservableEmitter interface {
 	Emitter
 	SetDisposable(disposable Disposable)
 	IsDisposed() bool
+	// Serialize returns an ObservableEmitter that can be called from several goroutines
+	Serialize() ObservableEmitter
 }
 
 type OnSubscribeCall func(ctx context.Context, ob ObservableEmitter)
 
+// Create creates an Observable emitting the signals pushed by onSubscribe, its Type is interface{}
 func Create(onSubscribe OnSubscribeCall) Observable {
-	return (&ObservableOnSubscribe{
+	return onAssembly((&ObservableOnSubscribe{
 		onSubscribe: onSubscribe,
-	}).Init()
+	}).Init())
 }
 
+// CreateOf creates an Observable emitting the signals pushed by onSubscribe, with the given element type.
+// typ is either a reflect.Type, or a sample value of the element type
+func CreateOf(typ interface{}, onSubscribe OnSubscribeCall) Observable {
+	t, ok := typ.(reflect.Type)
+	if !ok {
+		t = reflect.TypeOf(typ)
+	}
+	return onAssembly((&ObservableOnSubscribe{
+		typ:         t,
+		onSubscribe: onSubscribe,
+	}).Init())
+}
+
+// Just creates an Observable emitting the given items. Its Type is the type shared by all the items, or
+// interface{} if they differ
 func Just(items ...interface{}) Observable {
-	return (&ObservableOnSubscribe{
+	return onAssembly((&ObservableOnSubscribe{
+		typ: commonTypeOf(items),
 		onSubscribe: func(ctx context.Context, ob ObservableEmitter) {
 			for _, item := range items {
 				if ob.IsDisposed() {
@@ -59,5 +115,10 @@ func Just(items ...interface{}) Observable {
 			}
 			ob.OnComplete(ctx)
 		},
-	}).Init()
+	}).Init())
+}
+
+// Error creates an Observable that signals the given error to each observer right after subscription
+func Error(err error) Observable {
+	return errorObservable(emptyInterfaceType, err)
 }
@@ -2,29 +2,85 @@ package rx
 
 import (
 	"context"
-	"fmt"
+	"errors"
 	"reflect"
+	"slices"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
 
-func SliceConsumer(target interface{}) func(ctx context.Context, ele interface{}) error {
-	if target == nil {
-		panic("target should not be nil")
-	}
-	v := reflect.ValueOf(target)
-	t := v.Type()
-	if t.Kind() != reflect.Ptr {
-		panic(fmt.Sprintf("pointer to a slice is required but got %T", target))
-	}
-	if t.Elem().Kind() != reflect.Slice {
-		panic(fmt.Sprintf("pointer to a slice is required but got %T", target))
-	}
-	return func(ctx context.Context, ele interface{}) error {
-		v.Elem().Set(reflect.Append(v.Elem(), reflect.ValueOf(ele)))
-		return nil
-	}
+var errTest = errors.New("test")
+
+// testObserver records every signal it receives, and closes done on termination
+type testObserver struct {
+	mu         sync.Mutex
+	disposable Disposable
+	items      []interface{}
+	err        error
+	completed  bool
+	done       chan struct{}
+}
+
+func newTestObserver() *testObserver {
+	return &testObserver{done: make(chan struct{})}
+}
+
+func (o *testObserver) Type() reflect.Type {
+	return emptyInterfaceType
+}
+
+func (o *testObserver) OnSubscribe(disposable Disposable) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.disposable = disposable
+}
+
+func (o *testObserver) OnNext(ctx context.Context, msg interface{}) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.items = append(o.items, msg)
+}
+
+func (o *testObserver) OnError(ctx context.Context, err error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.err = err
+	close(o.done)
+}
+
+func (o *testObserver) OnComplete(ctx context.Context) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.completed = true
+	close(o.done)
+}
+
+func (o *testObserver) Dispose() {
+	o.mu.Lock()
+	disposable := o.disposable
+	o.mu.Unlock()
+	disposable.Dispose()
+}
+
+func (o *testObserver) Items() []interface{} {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return append([]interface{}(nil), o.items...)
+}
+
+func (o *testObserver) Err() error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.err
+}
+
+func (o *testObserver) Completed() bool {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.completed
 }
 
 func TestCreate(t *testing.T) {
@@ -44,7 +100,7 @@ func TestCreate(t *testing.T) {
 				ob.OnNext(ctx, i)
 			}
 			ob.OnComplete(ctx)
-		}).BlockingForEach(context.Background(), SliceConsumer(actual))
+		}).BlockingToSlice(context.Background(), actual)
 		if !assert.NoError(t, err, "should BlockingForEachObserver without error") {
 			return
 		}
@@ -52,6 +108,36 @@ func TestCreate(t *testing.T) {
 			return
 		}
 	})
+	t.Run("Create_should_CancelTheContextOnceDisposed", func(t *testing.T) {
+		woken := make(chan struct{})
+		value, err := Create(func(ctx context.Context, ob ObservableEmitter) {
+			go func() {
+				<-ctx.Done()
+				close(woken)
+			}()
+			ob.OnNext(ctx, 1)
+		}).BlockingFirst(context.Background())
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, 1, value)
+		select {
+		case <-woken:
+		case <-time.After(time.Second):
+			assert.Fail(t, "should cancel the context given to onSubscribe")
+		}
+	})
+	t.Run("Create_should_CancelTheContextOnConsumerErrors", func(t *testing.T) {
+		var callbackCtx context.Context
+		err := Create(func(ctx context.Context, ob ObservableEmitter) {
+			callbackCtx = ctx
+			ob.OnNext(ctx, 1)
+		}).BlockingForEach(context.Background(), func(int) error {
+			return errTest
+		})
+		assert.Equal(t, errTest, err)
+		assert.Equal(t, context.Canceled, callbackCtx.Err())
+	})
 }
 
 func TestJust(t *testing.T) {
@@ -63,7 +149,7 @@ func TestJust(t *testing.T) {
 		ctx := context.Background()
 		expect := []int{1, 2, 3}
 		ret := new([]int)
-		err := Just(1, 2, 3).BlockingForEach(ctx, SliceConsumer(ret))
+		err := Just(1, 2, 3).BlockingToSlice(ctx, ret)
 		if !assert.NoError(t, err, "should BlockingForEachObserver without error") {
 			return
 		}
@@ -72,3 +158,112 @@ func TestJust(t *testing.T) {
 		}
 	})
 }
+
+func TestCreateOf(t *testing.T) {
+	t.Run("CreateOf_should_AcceptAReflectType", func(t *testing.T) {
+		typ := reflect.TypeOf("")
+		assert.Equal(t, typ, CreateOf(typ, func(ctx context.Context, ob ObservableEmitter) {}).Type())
+	})
+
+	t.Run("CreateOf_should_AcceptASampleValue", func(t *testing.T) {
+		assert.Equal(t, reflect.TypeOf(0), CreateOf(0, func(ctx context.Context, ob ObservableEmitter) {}).Type())
+	})
+
+	t.Run("CreateOf_should_EmitElementsAsExpected", func(t *testing.T) {
+		ret := new([]int)
+		err := CreateOf(0, func(ctx context.Context, ob ObservableEmitter) {
+			ob.OnNext(ctx, 1)
+			ob.OnComplete(ctx)
+		}).BlockingToSlice(context.Background(), ret)
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, []int{1}, *ret)
+	})
+}
+
+func TestJust_Type(t *testing.T) {
+	tests := []struct {
+		name   string
+		items  []interface{}
+		expect reflect.Type
+	}{
+		{name: "Empty", items: nil, expect: emptyInterfaceType},
+		{name: "SameType", items: []interface{}{1, 2}, expect: reflect.TypeOf(0)},
+		{name: "MixedTypes", items: []interface{}{1, "a"}, expect: emptyInterfaceType},
+		{name: "Nil", items: []interface{}{nil}, expect: emptyInterfaceType},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expect, Just(tt.items...).Type())
+		})
+	}
+}
+
+// TestObservable_Type guards every Observable of the package against failing to report its Type
+func TestObservable_Type(t *testing.T) {
+	intType := reflect.TypeOf(0)
+	source := Just(1, 2, 3)
+	var group GroupedObservable
+	_ = source.GroupBy(func(i int) int { return i }).BlockingForEach(context.Background(), func(g GroupedObservable) {
+		if group == nil {
+			group = g
+		}
+	})
+	tests := []struct {
+		name       string
+		observable ObservableSource
+		expect     reflect.Type
+	}{
+		{name: "Create", observable: Create(func(ctx context.Context, ob ObservableEmitter) {}), expect: emptyInterfaceType},
+		{name: "CreateOf", observable: CreateOf(0, func(ctx context.Context, ob ObservableEmitter) {}), expect: intType},
+		{name: "Just", observable: source, expect: intType},
+		{name: "ErrorObservable", observable: errorObservable(emptyInterfaceType, errTest), expect: emptyInterfaceType},
+		{name: "FromChannel", observable: FromChannel(make(chan int)), expect: intType},
+		{name: "FromSlice", observable: FromSlice([]int{}), expect: intType},
+		{name: "FromMap", observable: FromMap(map[int]int{}), expect: mapEntryType},
+		{name: "FromSeq", observable: FromSeq(slices.Values([]int{})), expect: intType},
+		{name: "FromSeq2", observable: FromSeq2(slices.All([]int{})), expect: mapEntryType},
+		{name: "Range", observable: Range(0, 1), expect: intType},
+		{name: "Repeat", observable: Repeat(1, 1), expect: intType},
+		{name: "GroupBy", observable: source.GroupBy(func(i int) int { return i }), expect: groupedObservableType},
+		{name: "GroupedObservable", observable: group, expect: intType},
+		{name: "Distinct", observable: source.Distinct(), expect: intType},
+		{name: "DistinctUntilChanged", observable: source.DistinctUntilChanged(), expect: intType},
+		{name: "Count", observable: source.Count(), expect: intType},
+		{name: "Sum", observable: source.Sum(), expect: intType},
+		{name: "Min", observable: source.Min(), expect: intType},
+		{name: "Max", observable: source.Max(), expect: intType},
+		{name: "Average", observable: source.Average(), expect: float64Type},
+		{name: "All", observable: source.All(func(i int) bool { return true }), expect: boolType},
+		{name: "Any", observable: source.Any(func(i int) bool { return true }), expect: boolType},
+		{name: "Contains", observable: source.Contains(1), expect: boolType},
+		{name: "IsEmpty", observable: source.IsEmpty(), expect: boolType},
+		{name: "SequenceEqual", observable: source.SequenceEqual(source), expect: boolType},
+		{name: "First", observable: source.First(), expect: intType},
+		{name: "FirstOrDefault", observable: source.FirstOrDefault(0), expect: intType},
+		{name: "Last", observable: source.Last(), expect: intType},
+		{name: "ElementAt", observable: source.ElementAt(0), expect: intType},
+		{name: "Single", observable: source.Single(), expect: intType},
+		{name: "DefaultIfEmpty", observable: source.DefaultIfEmpty(0), expect: intType},
+		{name: "SwitchIfEmpty", observable: source.SwitchIfEmpty(source), expect: intType},
+		{name: "Publish", observable: source.Publish(), expect: intType},
+		{name: "Replay", observable: source.Replay(0, 0), expect: intType},
+		{name: "RefCount", observable: source.Publish().RefCount(1, 0), expect: intType},
+		{name: "AutoConnect", observable: source.Publish().AutoConnect(1), expect: intType},
+		{name: "Using", observable: Using(func() int { return 0 }, func(int) ObservableSource { return source }, nil, false), expect: emptyInterfaceType},
+		{name: "MergeDelayError", observable: MergeDelayError(source, source), expect: intType},
+		{name: "MergeDelayErrorOfMixedTypes", observable: MergeDelayError(source, errorObservable(emptyInterfaceType, errTest)), expect: emptyInterfaceType},
+		{name: "ConcatDelayError", observable: ConcatDelayError(source, source), expect: intType},
+		{name: "ZipDelayError", observable: ZipDelayError(func([]interface{}) string { return "" }, source), expect: reflect.TypeOf("")},
+		{name: "OnAssembly", observable: (&ObservableOnAssembly{source: source}).Init(), expect: intType},
+		{name: "Checkpoint", observable: source.Checkpoint("checkpoint"), expect: intType},
+		{name: "FromPublisher", observable: source.ToFlowable(BackpressureBuffer).ToObservable(), expect: intType},
+		{name: "AssemblyFailure", observable: source.Distinct(DistinctKeySelector(1)), expect: intType},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expect, tt.observable.Type())
+		})
+	}
+}